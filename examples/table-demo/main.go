@@ -73,12 +73,14 @@ func main() {
 			Comparator: table.NewNumericComparator("Priority"),
 		},
 		{
-			ID:        "external",
-			Title:     "External",
-			Width:     100,
-			Sortable:  true,
-			Editable:  false,
-			Alignment: table.AlignCenter,
+			ID:          "external",
+			Title:       "External",
+			Width:       100,
+			Sortable:    true,
+			Editable:    false,
+			Alignment:   table.AlignCenter,
+			BoolDisplay: table.BoolDisplayIcon,
+			Comparator:  table.NewBoolComparator("External"),
 		},
 	}
 
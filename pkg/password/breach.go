@@ -0,0 +1,87 @@
+package password
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Breach check
+//
+// CheckBreached asks the Have I Been Pwned "Pwned Passwords" range API how often a
+// password appears in known breaches. Only the first five hex characters of the
+// password's SHA-1 hash are sent (k-anonymity); the API returns every hash suffix with
+// that prefix and the match is found locally. This is the only part of the package
+// that uses the network, and CalculateStrength never calls it.
+
+// breachRangeURL is the HIBP range API; the hash prefix is appended
+const breachRangeURL = "https://api.pwnedpasswords.com/range/"
+
+// WithHTTPClient sets the HTTP client CheckBreached uses, e.g. one with a timeout or
+// proxy, or a stub in tests. The default is http.DefaultClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *PasswordStrengthCalculator) {
+		c.httpClient = client
+	}
+}
+
+// CheckBreached returns how many times password appears in the Have I Been Pwned
+// breach corpus, or 0 if it doesn't. The password itself never leaves the process:
+// only the first five characters of its SHA-1 hash are sent. With
+// StrengthConfig.TrimWhitespace set, surrounding whitespace is ignored here too.
+//
+//	count, err := calc.CheckBreached(ctx, text)
+//	if err == nil && count > 0 {
+//		warning.SetText(fmt.Sprintf("This password has appeared in %d data breaches", count))
+//	}
+func (c *PasswordStrengthCalculator) CheckBreached(ctx context.Context, password string) (int, error) {
+	if c.config.TrimWhitespace {
+		password = strings.TrimSpace(password) // Check the password the score was given for
+	}
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, breachRangeURL+prefix, nil)
+	if err != nil {
+		return 0, fmt.Errorf("breach check: %w", err)
+	}
+	req.Header.Set("Add-Padding", "true") // Hide the prefix's real number of suffixes
+	req.Header.Set("User-Agent", "fyne-components-password")
+
+	client := c.httpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("breach check: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("breach check: unexpected status %s", resp.Status)
+	}
+
+	// Each line is "SUFFIX:COUNT"; padding lines have a count of 0
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lineSuffix, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || !strings.EqualFold(lineSuffix, suffix) {
+			continue
+		}
+		n, err := strconv.Atoi(count)
+		if err != nil {
+			return 0, fmt.Errorf("breach check: invalid count %q", count)
+		}
+		return n, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("breach check: %w", err)
+	}
+	return 0, nil
+}
@@ -0,0 +1,150 @@
+package password
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Dictionary word detection
+//
+// A password is scanned for words from a wordlist, both as typed and with common
+// leetspeak substitutions undone (P@ssw0rd → password). The penalty grows with the
+// share of the password the matched words cover, so a word buried in a longer random
+// string costs less than a password made of words, and a password that is a single
+// word gets the full penalty.
+
+//go:embed wordlist.txt
+var defaultWordlist string
+
+const (
+	minDictionaryWordLength = 4  // Shorter words match too much random text to be meaningful
+	maxCoveragePenalty      = 12 // Penalty when matched words cover the whole password
+	singleWordPenalty       = 35 // Penalty when the whole password is one dictionary word
+)
+
+// leetSubstitutions maps leetspeak characters to the letters they stand for.
+// '1' and '!' may stand for either 'i' or 'l'; both readings are tried.
+var leetSubstitutions = map[rune]rune{
+	'@': 'a', '4': 'a', '8': 'b', '(': 'c', '3': 'e', '6': 'g', '9': 'g',
+	'0': 'o', '$': 's', '5': 's', '7': 't', '+': 't', '2': 'z',
+}
+
+// dictionary is a set of lowercase words to look for in passwords
+type dictionary struct {
+	words  map[string]struct{}
+	maxLen int // Length in bytes of the longest word
+}
+
+var (
+	defaultDictionaryOnce sync.Once
+	defaultDict           *dictionary
+)
+
+// defaultDictionary returns the dictionary built from the embedded wordlist
+func defaultDictionary() *dictionary {
+	defaultDictionaryOnce.Do(func() {
+		defaultDict = newDictionary(strings.Fields(defaultWordlist))
+	})
+	return defaultDict
+}
+
+// newDictionary builds a dictionary from words, ignoring case and words shorter
+// than minDictionaryWordLength
+func newDictionary(words []string) *dictionary {
+	d := &dictionary{words: make(map[string]struct{}, len(words))}
+	for _, word := range words {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if utf8.RuneCountInString(word) < minDictionaryWordLength {
+			continue
+		}
+		d.words[word] = struct{}{}
+		if len(word) > d.maxLen {
+			d.maxLen = len(word)
+		}
+	}
+	return d
+}
+
+// WithDictionary replaces the built-in wordlist with words. Matching ignores case,
+// and words shorter than four characters are ignored. An empty list disables
+// dictionary detection.
+func WithDictionary(words []string) Option {
+	return func(c *PasswordStrengthCalculator) {
+		c.dictionary = newDictionary(words)
+	}
+}
+
+// dictionaryPenalty returns the score penalty for dictionary words in password
+func (c *PasswordStrengthCalculator) dictionaryPenalty(password string) int {
+	penalty, _ := c.dictionaryMatch(password)
+	return penalty
+}
+
+// dictionaryMatch returns the score penalty for dictionary words in password and
+// the words it was based on, from the reading (as typed or without leetspeak) in
+// which the words cover the most of the password
+func (c *PasswordStrengthCalculator) dictionaryMatch(password string) (penalty int, words []string) {
+	dict := c.dictionary
+	if dict == nil {
+		dict = defaultDictionary()
+	}
+	if len(dict.words) == 0 || password == "" {
+		return 0, nil
+	}
+
+	lower := strings.ToLower(password)
+	length := utf8.RuneCountInString(lower)
+	best := 0
+	for _, candidate := range []string{lower, unleet(lower, 'i'), unleet(lower, 'l')} {
+		if _, ok := dict.words[candidate]; ok {
+			return singleWordPenalty, []string{candidate}
+		}
+		if covered, found := dict.match(candidate); covered > best {
+			best, words = covered, found
+		}
+	}
+	return maxCoveragePenalty * best / length, words
+}
+
+// match returns how many characters of s are part of a dictionary word, and the words
+// found. Words inside a longer match (e.g. "shine" in "sunshine") are not listed.
+func (d *dictionary) match(s string) (covered int, words []string) {
+	isCovered := make([]bool, len(s))
+	matchedTo := 0 // End of the furthest-reaching match so far
+	for start := range s {
+		for end := min(len(s), start+d.maxLen); end > start; end-- {
+			if _, ok := d.words[s[start:end]]; ok {
+				if end > matchedTo {
+					words = append(words, s[start:end])
+					matchedTo = end
+				}
+				for i := start; i < end; i++ {
+					isCovered[i] = true
+				}
+				break // Longest word starting here; shorter ones are inside it
+			}
+		}
+	}
+
+	for i := range s {
+		if isCovered[i] {
+			covered++ // Counts the first byte of each covered rune
+		}
+	}
+	return covered, words
+}
+
+// unleet undoes leetspeak substitutions in s, reading '1' and '!' as one
+func unleet(s string, one rune) string {
+	return strings.Map(func(r rune) rune {
+		if r == '1' || r == '!' {
+			return one
+		}
+		if letter, ok := leetSubstitutions[r]; ok {
+			return letter
+		}
+		return r
+	}, s)
+}
@@ -3,14 +3,17 @@
 // This package includes:
 //   - Password strength calculator with detailed analysis
 //   - Password strength meter widget with visual feedback
+//   - Password requirements checklist widget for a Policy
+//   - Password generator for a Policy and target strength
 //
 // # Password Strength Calculation
 //
 // The strength calculator analyzes passwords based on multiple criteria:
 //   - Length (minimum 8 characters recommended)
 //   - Character diversity (uppercase, lowercase, numbers, symbols)
-//   - Common pattern detection (repeated characters, sequences)
+//   - Common pattern detection (repeated characters, sequences, keyboard patterns)
 //   - Dictionary word detection
+//   - Entropy (length × log2 of the character pool), blended into the score
 //
 // Strength levels:
 //   - Weak: Basic passwords that are easily guessable
@@ -32,6 +35,41 @@
 //		strengthMeter.SetPassword(text)
 //	}
 //
+// # Minimum Strength
+//
+// Use MeetsMinimum or PasswordStrength.AtLeast to enforce a threshold, and
+// PasswordStrengthMeter.SetMinimumStrength to show it in the meter:
+//
+//	if !password.MeetsMinimum(text, password.StrengthGood) {
+//		return errors.New("password is too weak")
+//	}
+//
+// # Policies
+//
+// A Policy checks explicit rules (length, required character classes, repeated
+// characters, forbidden substrings) and reports each one a password breaks:
+//
+//	violations := password.Policy{MinLength: 12, Forbidden: []string{username}}.Validate(text)
+//
+// GeneratePassword creates a random password that meets a Policy and reaches a target
+// strength, using crypto/rand:
+//
+//	generated, err := password.GeneratePassword(policy, password.StrengthStrong)
+//
+// # Dictionary Words
+//
+// Passwords are checked against an embedded list of common English words, with
+// leetspeak undone (P@ssw0rd reads as password). A password that is one word is
+// penalized more than one with a word inside it. WithDictionary supplies another list:
+//
+//	calc := password.NewPasswordStrengthCalculator(password.WithDictionary(words))
+//
+// # Breach Check
+//
+// CheckBreached looks a password up in the Have I Been Pwned breach corpus, sending
+// only the first five characters of its SHA-1 hash. It is the only network call in the
+// package and is never made by CalculateStrength.
+//
 // # Thread Safety
 //
 // The strength calculator is stateless and thread-safe.
@@ -0,0 +1,114 @@
+package password
+
+import (
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Entropy
+//
+// Entropy estimates how many guesses a brute-force attack needs: length times log2 of
+// the pool of characters the password draws from. It rewards length where the
+// heuristic score rewards variety, so the two are blended: entropy moves the score up
+// or down by at most entropyMaxAdjustment points around entropyNeutralBits.
+
+// Character pool sizes used by CalculateEntropy
+const (
+	lowerPoolSize  = 26
+	upperPoolSize  = 26
+	digitPoolSize  = 10
+	symbolPoolSize = 33 // Printable ASCII punctuation and symbols
+)
+
+const (
+	entropyNeutralBits   = 60 // Entropy that neither raises nor lowers the score
+	entropyBitsPerPoint  = 4  // Bits above or below neutral per point of adjustment
+	entropyMaxAdjustment = 10 // Largest adjustment either way
+)
+
+// CalculateEntropy returns the password's entropy in bits: its length times log2 of
+// the size of the character pool it draws from (26 for lowercase, plus 26 for
+// uppercase, 10 for digits and 33 for symbols). Whitespace counts toward length but
+// not the pool.
+func (c *PasswordStrengthCalculator) CalculateEntropy(password string) float64 {
+	if c.config.TrimWhitespace {
+		password = strings.TrimSpace(password)
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSymbol bool
+	for _, char := range password {
+		switch {
+		case unicode.IsLower(char):
+			hasLower = true
+		case unicode.IsUpper(char):
+			hasUpper = true
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case unicode.IsPunct(char) || unicode.IsSymbol(char):
+			hasSymbol = true
+		}
+	}
+
+	pool := 0
+	if hasLower {
+		pool += lowerPoolSize
+	}
+	if hasUpper {
+		pool += upperPoolSize
+	}
+	if hasDigit {
+		pool += digitPoolSize
+	}
+	if hasSymbol {
+		pool += symbolPoolSize
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(password)) * math.Log2(float64(pool))
+}
+
+// entropyAdjustment converts entropy in bits to a score adjustment
+func entropyAdjustment(bits float64) int {
+	adjustment := int(math.Round((bits - entropyNeutralBits) / entropyBitsPerPoint))
+	return max(-entropyMaxAdjustment, min(entropyMaxAdjustment, adjustment))
+}
+
+// crackGuessesPerSecond is the attacker EstimateCrackTime assumes: an offline attack
+// on a fast hash with commodity GPUs
+const crackGuessesPerSecond = 1e10
+
+// EstimateCrackTime describes how long a brute-force attack would take on average to
+// guess a password with the given entropy in bits, as one of "instantly", "seconds",
+// "minutes", "hours", "days", "months", "years" or "centuries". It assumes
+// 10 billion guesses per second and that half the possibilities are tried.
+func EstimateCrackTime(bits float64) string {
+	seconds := math.Exp2(bits-1) / crackGuessesPerSecond
+	const (
+		minute = 60
+		hour   = 60 * minute
+		day    = 24 * hour
+		month  = 30 * day
+		year   = 365 * day
+	)
+	switch {
+	case seconds < 1:
+		return "instantly"
+	case seconds < minute:
+		return "seconds"
+	case seconds < hour:
+		return "minutes"
+	case seconds < day:
+		return "hours"
+	case seconds < month:
+		return "days"
+	case seconds < year:
+		return "months"
+	case seconds < 100*year:
+		return "years"
+	default:
+		return "centuries"
+	}
+}
@@ -0,0 +1,167 @@
+package password
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Password generation
+//
+// GeneratePassword draws characters with crypto/rand and scores each candidate with
+// the default calculator, so a generated password never contradicts the meter: it is
+// regenerated until it meets the policy, reaches the target strength and contains no
+// dictionary words or patterns.
+
+const (
+	generatedLength     = 16  // Length when the policy allows it
+	maxGenerateAttempts = 100 // Candidates tried before giving up on the target
+	generatorLowercase  = "abcdefghijklmnopqrstuvwxyz"
+	generatorUppercase  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	generatorDigits     = "0123456789"
+	generatorSymbols    = "!#%&*+-=?^_~" // No quotes, backslashes or leetspeak letters like @ and $
+)
+
+// ErrImpossiblePolicy is returned by GeneratePassword when no password can meet the
+// policy, e.g. MaxLength is below MinLength
+var ErrImpossiblePolicy = errors.New("policy cannot be met")
+
+// GeneratePassword returns a random password that meets policy and is at least as
+// strong as target. It is 16 characters long unless the policy's MinLength or
+// MaxLength requires otherwise, and uses all four character classes if it has room.
+// Characters forbidden by themselves in policy.Forbidden are never used.
+//
+// Returns an error wrapping ErrImpossiblePolicy if the policy contradicts itself, or
+// an error if no password within the policy reached target after repeated attempts
+// (e.g. a short MaxLength with a target of StrengthStrong).
+func GeneratePassword(policy Policy, target PasswordStrength) (string, error) {
+	classes := generatorClasses(policy)
+	length := generatedLength
+	if policy.MinLength > length {
+		length = policy.MinLength
+	}
+	if policy.MaxLength > 0 && policy.MaxLength < length {
+		length = policy.MaxLength
+	}
+
+	// Policies no password can meet
+	requires := []bool{policy.RequireLower, policy.RequireUpper, policy.RequireDigit, policy.RequireSymbol}
+	if policy.MaxLength > 0 && policy.MinLength > policy.MaxLength {
+		return "", fmt.Errorf("generate password: MinLength %d is above MaxLength %d: %w", policy.MinLength, policy.MaxLength, ErrImpossiblePolicy)
+	}
+	if strings.Join(classes, "") == "" {
+		return "", fmt.Errorf("generate password: Forbidden excludes every character: %w", ErrImpossiblePolicy)
+	}
+
+	// One character of each required class, then of the other classes while there's room
+	var required []string
+	for i, class := range classes {
+		if !requires[i] {
+			continue
+		}
+		if class == "" {
+			return "", fmt.Errorf("generate password: Forbidden excludes every character of a required class: %w", ErrImpossiblePolicy)
+		}
+		required = append(required, class)
+	}
+	if len(required) > length {
+		return "", fmt.Errorf("generate password: MaxLength %d is too short for %d required character classes: %w", policy.MaxLength, len(required), ErrImpossiblePolicy)
+	}
+	for i, class := range classes {
+		if !requires[i] && class != "" && len(required) < length {
+			required = append(required, class)
+		}
+	}
+
+	calc := NewPasswordStrengthCalculator()
+	for range maxGenerateAttempts {
+		candidate, err := generateCandidate(required, strings.Join(classes, ""), length)
+		if err != nil {
+			return "", fmt.Errorf("generate password: %w", err)
+		}
+		if policy.Validate(candidate) != nil {
+			continue
+		}
+		a := calc.Analyze(candidate)
+		if a.Strength.AtLeast(target) && a.PatternPenalty == 0 && a.DictionaryPenalty == 0 {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("generate password: no password of %d characters reached %v in %d attempts", length, target, maxGenerateAttempts)
+}
+
+// generatorClasses returns the characters GeneratePassword may use from each class
+// (lowercase, uppercase, digits, symbols), without any the policy forbids on their own
+func generatorClasses(policy Policy) []string {
+	forbidden := policy.forbidden()
+	allowed := func(char rune) bool {
+		for _, entry := range forbidden {
+			lower := strings.ToLower(string(char))
+			if entry == lower || entry == unleet(lower, 'i') || entry == unleet(lower, 'l') {
+				return false
+			}
+		}
+		return true
+	}
+
+	classes := []string{generatorLowercase, generatorUppercase, generatorDigits, generatorSymbols}
+	for i, class := range classes {
+		classes[i] = strings.Map(func(char rune) rune {
+			if allowed(char) {
+				return char
+			}
+			return -1
+		}, class)
+	}
+	return classes
+}
+
+// generateCandidate returns length random characters from all, including one from
+// each of required, in random order
+func generateCandidate(required []string, all string, length int) (string, error) {
+	password := make([]byte, 0, length)
+	for _, class := range required {
+		char, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, char)
+	}
+	for len(password) < length {
+		char, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, char)
+	}
+
+	// Fisher-Yates shuffle, so the required characters aren't always first
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j] = password[j], password[i]
+	}
+	return string(password), nil
+}
+
+// randomChar returns a random character of chars, which must be ASCII
+func randomChar(chars string) (byte, error) {
+	i, err := randomIndex(len(chars))
+	if err != nil {
+		return 0, err
+	}
+	return chars[i], nil
+}
+
+// randomIndex returns a uniformly random int in [0, n) from crypto/rand
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(i.Int64()), nil
+}
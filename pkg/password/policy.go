@@ -0,0 +1,142 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Password policies
+//
+// A Policy is a set of pass/fail rules, separate from scoring: a password can be
+// Strong and still break a rule such as a maximum length or a forbidden substring.
+
+// PolicyRule identifies one rule of a Policy
+type PolicyRule int
+
+const (
+	// RuleMinLength is Policy.MinLength
+	RuleMinLength PolicyRule = iota
+	// RuleMaxLength is Policy.MaxLength
+	RuleMaxLength
+	// RuleRequireUpper is Policy.RequireUpper
+	RuleRequireUpper
+	// RuleRequireLower is Policy.RequireLower
+	RuleRequireLower
+	// RuleRequireDigit is Policy.RequireDigit
+	RuleRequireDigit
+	// RuleRequireSymbol is Policy.RequireSymbol
+	RuleRequireSymbol
+	// RuleMaxRepeatRun is Policy.MaxRepeatRun
+	RuleMaxRepeatRun
+	// RuleForbidden is Policy.Forbidden
+	RuleForbidden
+)
+
+// String returns the name of the Policy field the rule comes from
+func (r PolicyRule) String() string {
+	switch r {
+	case RuleMinLength:
+		return "MinLength"
+	case RuleMaxLength:
+		return "MaxLength"
+	case RuleRequireUpper:
+		return "RequireUpper"
+	case RuleRequireLower:
+		return "RequireLower"
+	case RuleRequireDigit:
+		return "RequireDigit"
+	case RuleRequireSymbol:
+		return "RequireSymbol"
+	case RuleMaxRepeatRun:
+		return "MaxRepeatRun"
+	case RuleForbidden:
+		return "Forbidden"
+	default:
+		return "Unknown"
+	}
+}
+
+// Policy is a set of rules a password must meet. Zero values turn a rule off.
+type Policy struct {
+	MinLength     int      // Minimum characters (0 = no minimum)
+	MaxLength     int      // Maximum characters (0 = no maximum)
+	RequireUpper  bool     // true = at least one uppercase letter
+	RequireLower  bool     // true = at least one lowercase letter
+	RequireDigit  bool     // true = at least one digit
+	RequireSymbol bool     // true = at least one punctuation character or symbol
+	MaxRepeatRun  int      // Longest run of one character allowed, e.g. 2 rejects "aaa" (0 = no limit)
+	Forbidden     []string // Substrings not allowed, ignoring case and leetspeak, e.g. the user's name or email local part
+}
+
+// PolicyViolation is a rule a password doesn't meet
+type PolicyViolation struct {
+	Rule    PolicyRule
+	Message string // Human-readable, e.g. "Must be at least 12 characters"
+}
+
+// Validate returns the rules password doesn't meet, in the order of the Policy
+// fields, or nil if it meets them all. Lengths are counted in characters.
+func (p Policy) Validate(password string) []PolicyViolation {
+	var violations []PolicyViolation
+	add := func(rule PolicyRule, format string, args ...interface{}) {
+		violations = append(violations, PolicyViolation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		add(RuleMinLength, "Must be at least %d characters", p.MinLength)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		add(RuleMaxLength, "Must be at most %d characters", p.MaxLength)
+	}
+
+	if p.RequireUpper && !strings.ContainsFunc(password, unicode.IsUpper) {
+		add(RuleRequireUpper, "Must contain an uppercase letter")
+	}
+	if p.RequireLower && !strings.ContainsFunc(password, unicode.IsLower) {
+		add(RuleRequireLower, "Must contain a lowercase letter")
+	}
+	if p.RequireDigit && !strings.ContainsFunc(password, unicode.IsDigit) {
+		add(RuleRequireDigit, "Must contain a number")
+	}
+	if p.RequireSymbol && !strings.ContainsFunc(password, isSymbol) {
+		add(RuleRequireSymbol, "Must contain a symbol")
+	}
+
+	if p.MaxRepeatRun > 0 {
+		if run := repeatedRun(password, p.MaxRepeatRun+1); run != "" {
+			add(RuleMaxRepeatRun, "Must not repeat a character more than %d times in a row, as in '%s'", p.MaxRepeatRun, run)
+		}
+	}
+
+	lower := strings.ToLower(password)
+	readings := []string{lower, unleet(lower, 'i'), unleet(lower, 'l')}
+	for _, forbidden := range p.forbidden() {
+		for _, reading := range readings {
+			if strings.Contains(reading, forbidden) {
+				add(RuleForbidden, "Must not contain '%s'", forbidden)
+				break
+			}
+		}
+	}
+
+	return violations
+}
+
+// forbidden returns the Forbidden entries lowercased and trimmed, without empty ones
+func (p Policy) forbidden() []string {
+	var entries []string
+	for _, entry := range p.Forbidden {
+		if entry = strings.ToLower(strings.TrimSpace(entry)); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// isSymbol reports whether r is punctuation or a symbol, the symbol class used in scoring
+func isSymbol(r rune) bool {
+	return unicode.IsPunct(r) || unicode.IsSymbol(r)
+}
@@ -0,0 +1,134 @@
+package password
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// PasswordRequirementsList is a widget that lists a Policy's rules with a check or
+// cross next to each, updated as the user types
+type PasswordRequirementsList struct {
+	widget.BaseWidget
+
+	policy     Policy
+	rules      []PolicyRule // One row per rule the policy turns on, in Policy field order
+	icons      []*widget.Icon
+	labels     []*widget.Label
+	violations []PolicyViolation // From Validate for the current password
+	container  *fyne.Container
+}
+
+// Row icons for met and unmet rules
+var (
+	requirementMetIcon   fyne.Resource = theme.NewSuccessThemedResource(theme.ConfirmIcon())
+	requirementUnmetIcon fyne.Resource = theme.NewErrorThemedResource(theme.CancelIcon())
+)
+
+// NewPasswordRequirementsList creates a checklist of policy's rules, e.g.
+// "✓ At least 12 characters" and "✗ A number". Rules the policy leaves off are not
+// listed. The list starts with an empty password.
+func NewPasswordRequirementsList(policy Policy) *PasswordRequirementsList {
+	l := &PasswordRequirementsList{policy: policy}
+	l.container = container.NewVBox()
+	for _, rule := range policy.rules() {
+		icon := widget.NewIcon(requirementUnmetIcon)
+		label := widget.NewLabel(policy.requirement(rule))
+		l.rules = append(l.rules, rule)
+		l.icons = append(l.icons, icon)
+		l.labels = append(l.labels, label)
+		l.container.Add(container.NewBorder(nil, nil, icon, nil, label))
+	}
+
+	l.ExtendBaseWidget(l)
+	l.UpdatePassword("")
+	return l
+}
+
+// UpdatePassword checks password against the policy and updates each row's icon.
+// The result is the same as Policy.Validate's.
+func (l *PasswordRequirementsList) UpdatePassword(password string) {
+	l.violations = l.policy.Validate(password)
+	for i, rule := range l.rules {
+		if l.isMet(rule) {
+			l.icons[i].SetResource(requirementMetIcon)
+		} else {
+			l.icons[i].SetResource(requirementUnmetIcon)
+		}
+	}
+	l.Refresh()
+}
+
+// AllMet reports whether the current password meets every rule, e.g. to enable a
+// submit button
+func (l *PasswordRequirementsList) AllMet() bool {
+	return len(l.violations) == 0
+}
+
+// Violations returns the rules the current password doesn't meet, as from
+// Policy.Validate
+func (l *PasswordRequirementsList) Violations() []PolicyViolation {
+	return slices.Clone(l.violations)
+}
+
+// isMet reports whether the current password meets rule
+func (l *PasswordRequirementsList) isMet(rule PolicyRule) bool {
+	return !slices.ContainsFunc(l.violations, func(v PolicyViolation) bool { return v.Rule == rule })
+}
+
+// CreateRenderer implements fyne.Widget
+func (l *PasswordRequirementsList) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(l.container)
+}
+
+// rules returns the rules p turns on, in field order
+func (p Policy) rules() []PolicyRule {
+	var rules []PolicyRule
+	add := func(on bool, rule PolicyRule) {
+		if on {
+			rules = append(rules, rule)
+		}
+	}
+	add(p.MinLength > 0, RuleMinLength)
+	add(p.MaxLength > 0, RuleMaxLength)
+	add(p.RequireUpper, RuleRequireUpper)
+	add(p.RequireLower, RuleRequireLower)
+	add(p.RequireDigit, RuleRequireDigit)
+	add(p.RequireSymbol, RuleRequireSymbol)
+	add(p.MaxRepeatRun > 0, RuleMaxRepeatRun)
+	add(len(p.forbidden()) > 0, RuleForbidden)
+	return rules
+}
+
+// requirement describes what rule asks of a password, for a checklist row
+func (p Policy) requirement(rule PolicyRule) string {
+	switch rule {
+	case RuleMinLength:
+		return fmt.Sprintf("At least %d characters", p.MinLength)
+	case RuleMaxLength:
+		return fmt.Sprintf("At most %d characters", p.MaxLength)
+	case RuleRequireUpper:
+		return "An uppercase letter"
+	case RuleRequireLower:
+		return "A lowercase letter"
+	case RuleRequireDigit:
+		return "A number"
+	case RuleRequireSymbol:
+		return "A symbol"
+	case RuleMaxRepeatRun:
+		return fmt.Sprintf("No character more than %d times in a row", p.MaxRepeatRun)
+	case RuleForbidden:
+		var quoted []string
+		for _, forbidden := range p.forbidden() {
+			quoted = append(quoted, "'"+forbidden+"'")
+		}
+		return "Doesn't contain " + strings.Join(quoted, " or ")
+	default:
+		return rule.String()
+	}
+}
@@ -1,8 +1,10 @@
 package password
 
 import (
+	"net/http"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 // PasswordStrength represents the strength level of a password
@@ -39,79 +41,184 @@ func (s PasswordStrength) String() string {
 	}
 }
 
+// AtLeast reports whether s is the same as or stronger than other.
+// Strength levels are ordered from StrengthVeryWeak to StrengthStrong.
+func (s PasswordStrength) AtLeast(other PasswordStrength) bool {
+	return s >= other
+}
+
+// MeetsMinimum reports whether password's strength is at least min.
+// This is the canonical way to enforce a strength threshold, e.g. in form validation:
+//
+//	if !password.MeetsMinimum(text, password.StrengthGood) {
+//		return errors.New("password is too weak")
+//	}
+func MeetsMinimum(password string, min PasswordStrength) bool {
+	strength, _ := NewPasswordStrengthCalculator().CalculateStrength(password)
+	return strength.AtLeast(min)
+}
+
+// StrengthConfig configures how a PasswordStrengthCalculator scores passwords
+type StrengthConfig struct {
+	TrimWhitespace bool // true = ignore leading/trailing whitespace (e.g. a newline pasted from a password manager)
+}
+
+// Thresholds are the lowest scores (0-100) for each strength level. Scores below
+// Weak are StrengthVeryWeak.
+type Thresholds struct {
+	Weak   int
+	Fair   int
+	Good   int
+	Strong int
+}
+
+// DefaultThresholds returns the thresholds a calculator uses unless WithThresholds
+// sets others: 15, 35, 55 and 75
+func DefaultThresholds() Thresholds {
+	return Thresholds{Weak: 15, Fair: 35, Good: 55, Strong: 75}
+}
+
+// Valid reports whether the thresholds increase strictly from Weak to Strong and lie
+// within 1-100
+func (t Thresholds) Valid() bool {
+	return 0 < t.Weak && t.Weak < t.Fair && t.Fair < t.Good && t.Good < t.Strong && t.Strong <= 100
+}
+
 // PasswordStrengthCalculator calculates password strength based on various criteria
-type PasswordStrengthCalculator struct{}
+type PasswordStrengthCalculator struct {
+	config     StrengthConfig
+	thresholds Thresholds
+	dictionary *dictionary  // nil = the built-in wordlist
+	httpClient *http.Client // Used by CheckBreached only; nil = http.DefaultClient
+}
+
+// Option customizes a PasswordStrengthCalculator, e.g. WithDictionary
+type Option func(*PasswordStrengthCalculator)
+
+// WithThresholds sets the scores at which each strength level starts, e.g. a Strong
+// of 85 for a banking app. Thresholds that aren't Valid are ignored, leaving the
+// defaults in place.
+func WithThresholds(t Thresholds) Option {
+	return func(c *PasswordStrengthCalculator) {
+		if t.Valid() {
+			c.thresholds = t
+		}
+	}
+}
 
 // NewPasswordStrengthCalculator creates a new password strength calculator
-func NewPasswordStrengthCalculator() *PasswordStrengthCalculator {
-	return &PasswordStrengthCalculator{}
+func NewPasswordStrengthCalculator(opts ...Option) *PasswordStrengthCalculator {
+	return NewPasswordStrengthCalculatorWithConfig(StrengthConfig{}, opts...)
+}
+
+// NewPasswordStrengthCalculatorWithConfig creates a password strength calculator
+// that scores passwords according to config
+func NewPasswordStrengthCalculatorWithConfig(config StrengthConfig, opts ...Option) *PasswordStrengthCalculator {
+	c := &PasswordStrengthCalculator{config: config, thresholds: DefaultThresholds()}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Analysis is a per-criterion breakdown of a password's score, e.g. for showing
+// checkmarks next to a password entry
+type Analysis struct {
+	Length        int     // Characters, after trimming if StrengthConfig.TrimWhitespace is set
+	HasLower      bool    // Contains a lowercase letter
+	HasUpper      bool    // Contains an uppercase letter
+	HasDigit      bool    // Contains a digit
+	HasSymbol     bool    // Contains punctuation or a symbol
+	HasWhitespace bool    // Contains a space, tab or newline
+	UniqueRatio   float64 // Distinct characters / length (0-1)
+	Entropy       float64 // Bits; see CalculateEntropy
+	CrackTime     string  // Brute-force time for Entropy; see EstimateCrackTime ("" for an empty password)
+
+	PatternPenalty    int // Points deducted for common patterns, sequences and repeats
+	DictionaryPenalty int // Points deducted for dictionary words
+
+	Score    int              // 0-100
+	Strength PasswordStrength // Level for Score
 }
 
 // CalculateStrength calculates the strength of a password
 // Returns a strength level (0-4) and a score (0-100)
 func (c *PasswordStrengthCalculator) CalculateStrength(password string) (PasswordStrength, int) {
+	analysis := c.Analyze(password)
+	return analysis.Strength, analysis.Score
+}
+
+// Analyze scores a password and reports each criterion that went into the score
+func (c *PasswordStrengthCalculator) Analyze(password string) Analysis {
+	if c.config.TrimWhitespace {
+		password = strings.TrimSpace(password)
+	}
 	if password == "" {
-		return StrengthVeryWeak, 0
+		return Analysis{Strength: StrengthVeryWeak}
 	}
 
+	a := Analysis{Length: utf8.RuneCountInString(password)} // Runes, not bytes
 	score := 0
 
 	// Length scoring (0-30 points)
-	length := len(password)
 	switch {
-	case length >= 16:
+	case a.Length >= 16:
 		score += 30
-	case length >= 12:
+	case a.Length >= 12:
 		score += 25
-	case length >= 10:
+	case a.Length >= 10:
 		score += 20
-	case length >= 8:
+	case a.Length >= 8:
 		score += 15
-	case length >= 6:
+	case a.Length >= 6:
 		score += 10
 	default:
 		score += 5
 	}
 
-	// Character variety scoring (0-40 points)
-	var hasLower, hasUpper, hasNumber, hasSpecial bool
+	// Character variety scoring (0-45 points)
 	for _, char := range password {
 		if unicode.IsLower(char) {
-			hasLower = true
+			a.HasLower = true
 		} else if unicode.IsUpper(char) {
-			hasUpper = true
+			a.HasUpper = true
 		} else if unicode.IsDigit(char) {
-			hasNumber = true
+			a.HasDigit = true
 		} else if unicode.IsPunct(char) || unicode.IsSymbol(char) {
-			hasSpecial = true
+			a.HasSymbol = true
+		} else if unicode.IsSpace(char) {
+			a.HasWhitespace = true
 		}
 	}
 
-	if hasLower {
+	if a.HasLower {
 		score += 10
 	}
-	if hasUpper {
+	if a.HasUpper {
 		score += 10
 	}
-	if hasNumber {
+	if a.HasDigit {
 		score += 10
 	}
-	if hasSpecial {
+	if a.HasSymbol {
 		score += 10
 	}
+	if a.HasWhitespace {
+		score += 5 // Low value: spaces are easy to guess and often accidental
+	}
 
-	// Diversity bonus (0-15 points)
+	// Diversity bonus (0-15 points; whitespace does not count as a character type)
 	charTypes := 0
-	if hasLower {
+	if a.HasLower {
 		charTypes++
 	}
-	if hasUpper {
+	if a.HasUpper {
 		charTypes++
 	}
-	if hasNumber {
+	if a.HasDigit {
 		charTypes++
 	}
-	if hasSpecial {
+	if a.HasSymbol {
 		charTypes++
 	}
 
@@ -129,17 +236,27 @@ func (c *PasswordStrengthCalculator) CalculateStrength(password string) (Passwor
 	for _, char := range password {
 		uniqueChars[char] = true
 	}
-	uniqueRatio := float64(len(uniqueChars)) / float64(length)
-	if uniqueRatio >= 0.8 {
+	a.UniqueRatio = float64(len(uniqueChars)) / float64(a.Length)
+	if a.UniqueRatio >= 0.8 {
 		score += 10
-	} else if uniqueRatio >= 0.6 {
+	} else if a.UniqueRatio >= 0.6 {
 		score += 7
-	} else if uniqueRatio >= 0.4 {
+	} else if a.UniqueRatio >= 0.4 {
 		score += 5
 	}
 
-	// Pattern penalties (0 to -15 points)
-	score -= c.detectPatterns(password)
+	// Pattern penalties (0 to -25 points)
+	a.PatternPenalty = c.detectPatterns(password)
+	score -= a.PatternPenalty
+
+	// Dictionary word penalty (0 to -35 points)
+	a.DictionaryPenalty = c.dictionaryPenalty(password)
+	score -= a.DictionaryPenalty
+
+	// Entropy adjustment (-10 to +10 points)
+	a.Entropy = c.CalculateEntropy(password)
+	a.CrackTime = EstimateCrackTime(a.Entropy)
+	score += entropyAdjustment(a.Entropy)
 
 	// Ensure score stays within bounds
 	if score < 0 {
@@ -148,31 +265,27 @@ func (c *PasswordStrengthCalculator) CalculateStrength(password string) (Passwor
 		score = 100
 	}
 
-	// Convert score to strength level
-	strength := c.scoreToStrength(score)
+	a.Score = score
+	a.Strength = c.scoreToStrength(score)
+	return a
+}
 
-	return strength, score
+// commonPatterns are well-known passwords and fragments of them
+var commonPatterns = []string{
+	"password", "12345678", "qwerty", "111111", "000000",
+	"admin", "user", "login", "123456", "654321",
 }
 
 // detectPatterns looks for common patterns and returns a penalty score
 func (c *PasswordStrengthCalculator) detectPatterns(password string) int {
 	penalty := 0
-	lower := strings.ToLower(password)
 
 	// Common patterns - check for exact or prominent matches
-	commonPatterns := []string{
-		"password", "12345678", "qwerty", "111111", "000000",
-		"admin", "user", "login", "123456", "654321",
-	}
-
-	for _, pattern := range commonPatterns {
-		if strings.Contains(lower, pattern) {
-			penalty += 10
-			break // Only penalize once for patterns
-		}
+	if commonPattern(password) != "" {
+		penalty += 10 // Only penalize once for patterns
 	}
 
-	// Sequential characters (abc, 123, etc.)
+	// Sequential characters (abc, 123, cba, 321, etc.)
 	if c.hasSequentialChars(password, 3) {
 		penalty += 5
 	}
@@ -182,61 +295,170 @@ func (c *PasswordStrengthCalculator) detectPatterns(password string) int {
 		penalty += 5
 	}
 
+	// Keyboard patterns (asdf, 1qaz, etc.)
+	if c.hasKeyboardPattern(password, 3) {
+		penalty += 5
+	}
+
 	return penalty
 }
 
+// commonPattern returns the first of commonPatterns found in password, ignoring case,
+// or "" if there is none
+func commonPattern(password string) string {
+	lower := strings.ToLower(password)
+	for _, pattern := range commonPatterns {
+		if strings.Contains(lower, pattern) {
+			return pattern
+		}
+	}
+	return ""
+}
+
 // hasSequentialChars checks if the password has sequential characters
 func (c *PasswordStrengthCalculator) hasSequentialChars(password string, minLength int) bool {
-	if len(password) < minLength {
-		return false
+	return sequentialRun(password, minLength) != ""
+}
+
+// sequentialRun returns the first run of minLength ascending (abc, 123) or
+// descending (cba, 321) characters in password, or "" if there is none
+func sequentialRun(password string, minLength int) string {
+	runes := []rune(password)
+	if len(runes) < minLength {
+		return ""
 	}
 
-	for i := 0; i <= len(password)-minLength; i++ {
-		isSequential := true
-		for j := 1; j < minLength; j++ {
-			if password[i+j] != password[i+j-1]+1 {
-				isSequential = false
-				break
+	for i := 0; i <= len(runes)-minLength; i++ {
+		for _, step := range []rune{1, -1} {
+			isSequential := true
+			for j := 1; j < minLength; j++ {
+				if runes[i+j] != runes[i+j-1]+step {
+					isSequential = false
+					break
+				}
+			}
+			if isSequential {
+				return string(runes[i : i+minLength])
 			}
-		}
-		if isSequential {
-			return true
 		}
 	}
-	return false
+	return ""
 }
 
 // hasRepeatedChars checks if the password has repeated characters
 func (c *PasswordStrengthCalculator) hasRepeatedChars(password string, minLength int) bool {
-	if len(password) < minLength {
-		return false
+	return repeatedRun(password, minLength) != ""
+}
+
+// repeatedRun returns the first run of minLength identical characters (aaa, 111)
+// in password, or "" if there is none
+func repeatedRun(password string, minLength int) string {
+	runes := []rune(password)
+	if len(runes) < minLength {
+		return ""
 	}
 
-	for i := 0; i <= len(password)-minLength; i++ {
+	for i := 0; i <= len(runes)-minLength; i++ {
 		allSame := true
 		for j := 1; j < minLength; j++ {
-			if password[i+j] != password[i] {
+			if runes[i+j] != runes[i] {
 				allSame = false
 				break
 			}
 		}
 		if allSame {
-			return true
+			return string(runes[i : i+minLength])
+		}
+	}
+	return ""
+}
+
+// keyboardRows is the QWERTY layout, unshifted and shifted. Each row is offset half a
+// key to the right of the one above, so the key below another is at the same or the
+// previous column: 'q' sits below '1' and '2', and 'a' below 'q' and 'w'.
+var keyboardRows = [][2]string{
+	{"1234567890-=", "!@#$%^&*()_+"},
+	{"qwertyuiop[]", "QWERTYUIOP{}"},
+	{"asdfghjkl;'", "ASDFGHJKL:\""},
+	{"zxcvbnm,./", "ZXCVBNM<>?"},
+}
+
+// keyPosition is a key's row and column in keyboardRows
+type keyPosition struct{ row, col int }
+
+// keyPositions maps each character on the keyboard to its key
+var keyPositions = func() map[rune]keyPosition {
+	positions := make(map[rune]keyPosition)
+	for row, keys := range keyboardRows {
+		for _, layer := range keys {
+			for col, char := range []rune(layer) {
+				positions[char] = keyPosition{row, col}
+			}
+		}
+	}
+	return positions
+}()
+
+// keyStep returns the move from key a to key b, and whether the keys are neighbors:
+// beside each other in a row, or touching in the row above or below
+func keyStep(a, b keyPosition) (step keyPosition, adjacent bool) {
+	step = keyPosition{b.row - a.row, b.col - a.col}
+	switch step {
+	case keyPosition{0, -1}, keyPosition{0, 1}, // Same row
+		keyPosition{1, 0}, keyPosition{1, -1}, // Row below
+		keyPosition{-1, 0}, keyPosition{-1, 1}: // Row above
+		return step, true
+	}
+	return step, false
+}
+
+// hasKeyboardPattern checks if the password has a run of minLength neighboring keys
+// walked in one direction, e.g. "asd", "ytr" or "1qaz"
+func (c *PasswordStrengthCalculator) hasKeyboardPattern(password string, minLength int) bool {
+	return keyboardRun(password, minLength) != ""
+}
+
+// keyboardRun returns the first run of minLength keys in password that are neighbors
+// on a QWERTY keyboard, each a step in the same direction from the last, or "" if
+// there is none. Keeping one direction ("qwe", "zaq") stops words like "red", whose
+// keys touch but zigzag, from matching. Runs that are also sequences are skipped.
+func keyboardRun(password string, minLength int) string {
+	runes := []rune(password)
+	start := 0
+	var direction keyPosition
+	for i := 1; i < len(runes); i++ {
+		prev, okPrev := keyPositions[runes[i-1]]
+		cur, okCur := keyPositions[runes[i]]
+		step, adjacent := keyStep(prev, cur)
+		switch {
+		case !okPrev || !okCur || !adjacent:
+			start = i
+		case i-start == 1 || step == direction:
+			// Starts or continues a run
+		default:
+			start = i - 1 // Changes direction: a new run from the previous key
+		}
+		direction = step
+		if length := i - start + 1; length >= minLength {
+			// Runs that are also sequences ("123", "cde") are already penalized as such
+			if run := string(runes[start : i+1]); sequentialRun(run, length) == "" {
+				return run
+			}
 		}
 	}
-	return false
+	return ""
 }
 
 // scoreToStrength converts a numeric score to a strength level
 func (c *PasswordStrengthCalculator) scoreToStrength(score int) PasswordStrength {
 	switch {
-	case score >= 75:
+	case score >= c.thresholds.Strong:
 		return StrengthStrong
-	case score >= 55:
+	case score >= c.thresholds.Good:
 		return StrengthGood
-	case score >= 35:
+	case score >= c.thresholds.Fair:
 		return StrengthFair
-	case score >= 15:
+	case score >= c.thresholds.Weak:
 		return StrengthWeak
 	default:
 		return StrengthVeryWeak
@@ -1,7 +1,9 @@
 package password
 
 import (
+	"fmt"
 	"image/color"
+	"maps"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
@@ -13,19 +15,41 @@ import (
 type PasswordStrengthMeter struct {
 	widget.BaseWidget
 
+	// OnStrengthChanged is called when UpdatePassword changes the strength level,
+	// e.g. to enable a submit button once the password is Good. It is not called
+	// while typing keeps the same level.
+	OnStrengthChanged func(strength PasswordStrength, score int)
+
 	calculator  *PasswordStrengthCalculator
 	password    string
 	strength    PasswordStrength
 	score       int
+	crackTime   string           // From the calculator's Analysis; "" for an empty password
+	minimum     PasswordStrength // Required strength; StrengthVeryWeak = no requirement
+	privacyMode bool             // true = bar width shows only the strength level, not the score
+	showDetails bool             // true = label adds the score and crack time
+
+	colors      map[PasswordStrength]color.Color // Overrides defaultStrengthColors; see SetColors
 	strengthBar *canvas.Rectangle
+	segments    []*canvas.Rectangle // Non-nil = segmented meter, shown instead of strengthBar
 	labelWidget *widget.Label
 	container   *fyne.Container
 }
 
 // NewPasswordStrengthMeter creates a new password strength meter widget
 func NewPasswordStrengthMeter() *PasswordStrengthMeter {
+	return NewPasswordStrengthMeterWithCalculator(NewPasswordStrengthCalculator())
+}
+
+// NewPasswordStrengthMeterWithCalculator creates a password strength meter that scores
+// passwords with calc, e.g. one built with NewPasswordStrengthCalculatorWithConfig.
+// A nil calc uses the default calculator.
+func NewPasswordStrengthMeterWithCalculator(calc *PasswordStrengthCalculator) *PasswordStrengthMeter {
+	if calc == nil {
+		calc = NewPasswordStrengthCalculator()
+	}
 	meter := &PasswordStrengthMeter{
-		calculator: NewPasswordStrengthCalculator(),
+		calculator: calc,
 	}
 
 	// Create visual elements
@@ -47,39 +71,86 @@ func NewPasswordStrengthMeter() *PasswordStrengthMeter {
 	return meter
 }
 
+// NewSegmentedStrengthMeter creates a password strength meter that shows the level as
+// a row of segments, filled from the left in the level's color: one for Very Weak, all
+// for Strong, and evenly spaced counts in between. With 5 segments, each level fills
+// one more. segments below 1 are treated as 5.
+func NewSegmentedStrengthMeter(segments int) *PasswordStrengthMeter {
+	if segments < 1 {
+		segments = 5
+	}
+	meter := NewPasswordStrengthMeter()
+
+	meter.segments = make([]*canvas.Rectangle, segments)
+	bar := container.NewGridWithColumns(segments)
+	for i := range meter.segments {
+		meter.segments[i] = canvas.NewRectangle(emptySegmentColor)
+		meter.segments[i].SetMinSize(fyne.NewSize(200/float32(segments), 8))
+		bar.Add(meter.segments[i])
+	}
+	meter.container.Objects[0] = bar
+
+	meter.updateAppearance()
+	return meter
+}
+
+// defaultStrengthColors are the bar colors for each level unless SetColors overrides them
+var defaultStrengthColors = map[PasswordStrength]color.Color{
+	StrengthVeryWeak: color.RGBA{R: 220, G: 53, B: 69, A: 255},  // Red
+	StrengthWeak:     color.RGBA{R: 253, G: 126, B: 20, A: 255}, // Orange
+	StrengthFair:     color.RGBA{R: 255, G: 193, B: 7, A: 255},  // Yellow
+	StrengthGood:     color.RGBA{R: 40, G: 167, B: 69, A: 255},  // Light Green
+	StrengthStrong:   color.RGBA{R: 25, G: 135, B: 84, A: 255},  // Dark Green
+}
+
+// emptySegmentColor fills the segments of a segmented meter above the current level
+var emptySegmentColor color.Color = color.RGBA{R: 200, G: 200, B: 200, A: 255} // Gray
+
+// filledSegments returns how many of a segmented meter's segments the current level
+// fills: 1 for StrengthVeryWeak up to all of them for StrengthStrong
+func (m *PasswordStrengthMeter) filledSegments() int {
+	levels := int(StrengthStrong) // Steps from Very Weak to Strong
+	return 1 + (int(m.strength)*(len(m.segments)-1)+levels/2)/levels
+}
+
 // UpdatePassword updates the meter based on the new password
 func (m *PasswordStrengthMeter) UpdatePassword(password string) {
+	previous := m.strength
 	m.password = password
-	m.strength, m.score = m.calculator.CalculateStrength(password)
+	analysis := m.calculator.Analyze(password)
+	m.strength, m.score, m.crackTime = analysis.Strength, analysis.Score, analysis.CrackTime
 
 	// Update visual appearance
 	m.updateAppearance()
+
+	if m.strength != previous && m.OnStrengthChanged != nil {
+		m.OnStrengthChanged(m.strength, m.score)
+	}
 }
 
 // updateAppearance updates the color and label based on current strength
 func (m *PasswordStrengthMeter) updateAppearance() {
-	var barColor color.Color
-	var labelText string
-
-	switch m.strength {
-	case StrengthVeryWeak:
-		barColor = color.RGBA{R: 220, G: 53, B: 69, A: 255} // Red
-		labelText = "Password Strength: Very Weak"
-	case StrengthWeak:
-		barColor = color.RGBA{R: 255, G: 193, B: 7, A: 255} // Yellow/Orange
-		labelText = "Password Strength: Weak"
-	case StrengthFair:
-		barColor = color.RGBA{R: 255, G: 193, B: 7, A: 255} // Yellow
-		labelText = "Password Strength: Fair"
-	case StrengthGood:
-		barColor = color.RGBA{R: 40, G: 167, B: 69, A: 255} // Light Green
-		labelText = "Password Strength: Good"
-	case StrengthStrong:
-		barColor = color.RGBA{R: 25, G: 135, B: 84, A: 255} // Dark Green
-		labelText = "Password Strength: Strong"
-	default:
+	barColor := m.colors[m.strength]
+	if barColor == nil {
+		barColor = defaultStrengthColors[m.strength]
+	}
+	if barColor == nil {
 		barColor = color.RGBA{R: 200, G: 200, B: 200, A: 255} // Gray
-		labelText = "Password Strength: Unknown"
+	}
+	labelText := "Password Strength: " + m.strength.String()
+
+	// Details, e.g. "Strong (82/100) — centuries"; both reveal the length, so privacy
+	// mode leaves them out
+	if m.showDetails && !m.privacyMode {
+		labelText += fmt.Sprintf(" (%d/100)", m.score)
+		if m.crackTime != "" {
+			labelText += " — " + m.crackTime
+		}
+	}
+
+	// Show the requirement while it isn't met
+	if !m.MeetsMinimum() {
+		labelText += " (minimum: " + m.minimum.String() + ")"
 	}
 
 	// Update bar color and size based on score
@@ -87,11 +158,26 @@ func (m *PasswordStrengthMeter) updateAppearance() {
 
 	// Calculate bar width based on score (0-100 maps to 0-200 pixels)
 	barWidth := float32(m.score) * 2.0
+	if m.privacyMode {
+		// One width per level (40-200 pixels), so the bar doesn't reveal the exact score or length
+		barWidth = float32(m.strength+1) * 40
+	}
 	if barWidth < 20 {
 		barWidth = 20 // Minimum visible width
 	}
 	m.strengthBar.SetMinSize(fyne.NewSize(barWidth, 8))
 
+	// Segmented meter: fill segments up to the level
+	filled := m.filledSegments()
+	for i, segment := range m.segments {
+		if i < filled {
+			segment.FillColor = barColor
+		} else {
+			segment.FillColor = emptySegmentColor
+		}
+		segment.Refresh()
+	}
+
 	// Update label
 	m.labelWidget.SetText(labelText)
 
@@ -108,6 +194,65 @@ func (m *PasswordStrengthMeter) GetStrength() PasswordStrength {
 	return m.strength
 }
 
+// SetMinimumStrength sets the strength the password must reach. While the current
+// password is weaker, the label shows the requirement and MeetsMinimum returns false.
+func (m *PasswordStrengthMeter) SetMinimumStrength(min PasswordStrength) {
+	m.minimum = min
+	m.updateAppearance()
+}
+
+// GetMinimumStrength returns the required strength set with SetMinimumStrength
+func (m *PasswordStrengthMeter) GetMinimumStrength() PasswordStrength {
+	return m.minimum
+}
+
+// MeetsMinimum reports whether the current password reaches the minimum strength,
+// e.g. to enable a submit button
+func (m *PasswordStrengthMeter) MeetsMinimum() bool {
+	return m.strength.AtLeast(m.minimum)
+}
+
+// SetPrivacyMode sets whether the bar width only reflects the strength level.
+// By default the width is proportional to the score, which can reveal the password
+// length to onlookers; in privacy mode it snaps to one of five widths, one per level,
+// and the label leaves out the details of SetShowDetails. GetScore still returns the
+// exact score.
+func (m *PasswordStrengthMeter) SetPrivacyMode(enabled bool) {
+	m.privacyMode = enabled
+	m.updateAppearance()
+}
+
+// SetColors overrides the bar color for the given levels, e.g. to match a theme or
+// meet contrast requirements. Levels not in colors keep their default color, and nil
+// restores all the defaults. The meter is redrawn straight away.
+//
+//	meter.SetColors(map[password.PasswordStrength]color.Color{
+//		password.StrengthStrong: theme.Color(theme.ColorNameSuccess),
+//	})
+func (m *PasswordStrengthMeter) SetColors(colors map[PasswordStrength]color.Color) {
+	m.colors = maps.Clone(colors)
+	m.updateAppearance()
+}
+
+// SetShowDetails sets whether the label adds the numeric score and an estimated
+// crack time to the level, e.g. "Password Strength: Strong (82/100) — centuries".
+// The crack time comes from EstimateCrackTime and is left out for an empty password.
+// Details are not shown in privacy mode (see SetPrivacyMode).
+func (m *PasswordStrengthMeter) SetShowDetails(show bool) {
+	m.showDetails = show
+	m.updateAppearance()
+}
+
+// SetCalculator replaces the calculator used to score passwords and re-scores the
+// current password with it. A nil calc restores the default calculator.
+func (m *PasswordStrengthMeter) SetCalculator(calc *PasswordStrengthCalculator) {
+	if calc == nil {
+		calc = NewPasswordStrengthCalculator()
+	}
+	m.calculator = calc
+	m.UpdatePassword(m.password)
+}
+
 // GetScore returns the current score (0-100)
 func (m *PasswordStrengthMeter) GetScore() int {
 	return m.score
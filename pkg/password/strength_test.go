@@ -1,7 +1,18 @@
 package password
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
 	"testing"
+
+	"fyne.io/fyne/v2/test"
 )
 
 func TestPasswordStrength_String(t *testing.T) {
@@ -182,6 +193,72 @@ func TestCalculateStrength_CharacterVariety(t *testing.T) {
 	}
 }
 
+func TestCalculateStrength_TrimWhitespace(t *testing.T) {
+	calc := NewPasswordStrengthCalculatorWithConfig(StrengthConfig{TrimWhitespace: true})
+
+	for _, padded := range []string{"password   ", "password\n", "\t password \r\n"} {
+		strength, score := calc.CalculateStrength(padded)
+		wantStrength, wantScore := calc.CalculateStrength("password")
+		if strength != wantStrength || score != wantScore {
+			t.Errorf("CalculateStrength(%q) = %v/%d, want %v/%d as for \"password\"", padded, strength, score, wantStrength, wantScore)
+		}
+	}
+
+	if strength, score := calc.CalculateStrength(" \n "); strength != StrengthVeryWeak || score != 0 {
+		t.Errorf("Whitespace-only password = %v/%d, want Very Weak/0", strength, score)
+	}
+
+	// Without trimming, trailing whitespace still counts as length
+	_, untrimmed := NewPasswordStrengthCalculator().CalculateStrength("password   ")
+	_, trimmed := calc.CalculateStrength("password   ")
+	if untrimmed <= trimmed {
+		t.Errorf("Expected untrimmed score %d to exceed trimmed score %d", untrimmed, trimmed)
+	}
+}
+
+func TestCalculateStrength_UnicodeLength(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	// Same rune count, character classes and uniqueness; é and 🔒 take 2 and 4 bytes
+	unicodePass := "café🔒1A"
+	asciiPass := "cafx$1A"
+
+	got := calc.Analyze(unicodePass)
+	want := calc.Analyze(asciiPass)
+	if got.Length != 7 {
+		t.Errorf("Length = %d, want 7 runes", got.Length)
+	}
+	if got.UniqueRatio != 1 {
+		t.Errorf("UniqueRatio = %.2f, want 1", got.UniqueRatio)
+	}
+	if got.Score != want.Score || got.Strength != want.Strength {
+		t.Errorf("%q = %v/%d, want %v/%d as for %q", unicodePass, got.Strength, got.Score, want.Strength, want.Score, asciiPass)
+	}
+
+	// Multibyte runes are not mistaken for sequences or repeats
+	if !calc.hasSequentialChars("éêë", 3) || calc.hasSequentialChars("日本語", 3) {
+		t.Error("Expected sequences to be detected by rune")
+	}
+	if !calc.hasRepeatedChars("🔒🔒🔒", 3) || calc.hasRepeatedChars("🔒🔑", 3) {
+		t.Error("Expected repeats to be detected by rune")
+	}
+}
+
+func TestCalculateStrength_WhitespaceClass(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	// Interior whitespace is a character class, worth less than a symbol
+	_, plain := calc.CalculateStrength("Xk9mQw2pRt")
+	_, spaced := calc.CalculateStrength("Xk9m Qw2pR")
+	_, symbol := calc.CalculateStrength("Xk9m!Qw2pR")
+	if spaced != plain+5 {
+		t.Errorf("Expected whitespace to add 5 points: plain=%d, spaced=%d", plain, spaced)
+	}
+	if spaced >= symbol {
+		t.Errorf("Expected whitespace to be worth less than a symbol: spaced=%d, symbol=%d", spaced, symbol)
+	}
+}
+
 func TestCalculateStrength_CommonPatterns(t *testing.T) {
 	calc := NewPasswordStrengthCalculator()
 
@@ -260,6 +337,159 @@ func TestCalculateStrength_ScoreBounds(t *testing.T) {
 	}
 }
 
+func TestCalculateEntropy(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	tests := []struct {
+		password string
+		want     float64
+	}{
+		{"", 0},
+		{"   ", 0},
+		{"abcdefgh", 8 * math.Log2(26)},
+		{"AbCdEfGh", 8 * math.Log2(52)},
+		{"Ab1!", 4 * math.Log2(95)},
+		{"ab 12", 5 * math.Log2(36)}, // Whitespace adds length, not pool
+	}
+
+	for _, tt := range tests {
+		if got := calc.CalculateEntropy(tt.password); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("CalculateEntropy(%q) = %.2f, want %.2f", tt.password, got, tt.want)
+		}
+	}
+}
+
+func TestAnalyze_EntropyBlend(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	long := calc.Analyze("qzxvbnmkjhgfdswrtypl") // 20 characters, lowercase only
+	varied := calc.Analyze("Xk9#mQ2$pR7!")       // 12 characters, four classes
+	if long.Entropy <= varied.Entropy {
+		t.Fatalf("Expected more entropy for the longer password: long=%.1f, varied=%.1f", long.Entropy, varied.Entropy)
+	}
+	if long.Score >= varied.Score {
+		t.Errorf("Expected the four-class password to score higher: long=%d, varied=%d", long.Score, varied.Score)
+	}
+
+	strength, score := calc.CalculateStrength("Xk9#mQ2$pR7!")
+	if strength != varied.Strength || score != varied.Score {
+		t.Errorf("CalculateStrength = %v/%d, want %v/%d as from Analyze", strength, score, varied.Strength, varied.Score)
+	}
+	if varied.Entropy != calc.CalculateEntropy("Xk9#mQ2$pR7!") {
+		t.Errorf("Analysis.Entropy = %.2f, want CalculateEntropy", varied.Entropy)
+	}
+
+	if got := entropyAdjustment(entropyNeutralBits); got != 0 {
+		t.Errorf("entropyAdjustment(neutral) = %d, want 0", got)
+	}
+	if got := entropyAdjustment(1000); got != entropyMaxAdjustment {
+		t.Errorf("entropyAdjustment(1000) = %d, want %d", got, entropyMaxAdjustment)
+	}
+	if got := entropyAdjustment(0); got != -entropyMaxAdjustment {
+		t.Errorf("entropyAdjustment(0) = %d, want %d", got, -entropyMaxAdjustment)
+	}
+}
+
+func TestAnalyze_Breakdown(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	tests := []struct {
+		password string
+		want     Analysis // Entropy, CrackTime, Score and Strength are checked separately
+	}{
+		{"", Analysis{}},
+		{"password", Analysis{
+			Length: 8, HasLower: true, UniqueRatio: 0.875,
+			PatternPenalty: 10, DictionaryPenalty: singleWordPenalty,
+		}},
+		{"Xk9#mQ2$pR7!", Analysis{
+			Length: 12, HasLower: true, HasUpper: true, HasDigit: true, HasSymbol: true, UniqueRatio: 1,
+		}},
+		{"aaa bbb", Analysis{
+			Length: 7, HasLower: true, HasWhitespace: true, UniqueRatio: 3.0 / 7,
+			PatternPenalty: 5,
+		}},
+		{"AAAA1111", Analysis{
+			Length: 8, HasUpper: true, HasDigit: true, UniqueRatio: 0.25,
+			PatternPenalty: 5,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			got := calc.Analyze(tt.password)
+			wantStrength, wantScore := calc.CalculateStrength(tt.password)
+			if got.Score != wantScore || got.Strength != wantStrength {
+				t.Errorf("Score/Strength = %d/%v, want %d/%v as from CalculateStrength", got.Score, got.Strength, wantScore, wantStrength)
+			}
+			if got.Entropy != calc.CalculateEntropy(tt.password) {
+				t.Errorf("Entropy = %.2f, want %.2f", got.Entropy, calc.CalculateEntropy(tt.password))
+			}
+
+			wantCrackTime := EstimateCrackTime(got.Entropy)
+			if tt.password == "" {
+				wantCrackTime = ""
+			}
+			if got.CrackTime != wantCrackTime {
+				t.Errorf("CrackTime = %q, want %q", got.CrackTime, wantCrackTime)
+			}
+
+			got.Entropy, got.CrackTime, got.Score, got.Strength = 0, "", 0, 0
+			if got != tt.want {
+				t.Errorf("Analyze(%q) =\n%+v\nwant\n%+v", tt.password, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuggestions(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	tests := []struct {
+		password string
+		want     []string
+	}{
+		{"", []string{"Enter a password"}},
+		{"Xk9#mQ2$pR7!vB4@", []string{}},
+		{"P@ssw0rd", []string{"Make it at least 12 characters", "Avoid the common word 'password'"}},
+		{"Password123", []string{
+			"Make it at least 12 characters", "Add a symbol",
+			"Avoid the common word 'password'", "Avoid sequences like '123'",
+		}},
+		{"aaa bbb", []string{
+			"Make it at least 12 characters", "Add an uppercase letter", "Add a number", "Add a symbol",
+			"Avoid repeated characters like 'aaa'",
+		}},
+		{"Zq9#Rx7%sunshine", []string{"Avoid the common word 'sunshine'"}},
+		{"Xk9#mQ2$pR7!Xk9#", []string{"Use more different characters"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			got := calc.Suggestions(tt.password)
+			if got == nil || !slices.Equal(got, tt.want) {
+				t.Errorf("Suggestions(%q) = %q, want %q", tt.password, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSuggestions_AgreeWithScore(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	// Following a suggestion raises the score
+	steps := []string{"sunshine", "sunshine42", "Sunshine42", "Sunshine42!", "Vx#Sunshine42!mq"}
+	for i := 1; i < len(steps); i++ {
+		before, after := calc.Analyze(steps[i-1]).Score, calc.Analyze(steps[i]).Score
+		if after <= before {
+			t.Errorf("%q scores %d, not above %q at %d", steps[i], after, steps[i-1], before)
+		}
+		if len(calc.Suggestions(steps[i])) >= len(calc.Suggestions(steps[i-1])) {
+			t.Errorf("%q has no fewer suggestions than %q", steps[i], steps[i-1])
+		}
+	}
+}
+
 func TestDetectPatterns(t *testing.T) {
 	calc := NewPasswordStrengthCalculator()
 
@@ -273,6 +503,8 @@ func TestDetectPatterns(t *testing.T) {
 		{"has 12345", "Pass12345word", false},
 		{"has qwerty", "Qwerty789", false},
 		{"has admin", "Admin123", false},
+		{"has 321", "Pass321word", false},
+		{"has cba", "cbaSecure", false},
 	}
 
 	for _, tt := range tests {
@@ -288,6 +520,58 @@ func TestDetectPatterns(t *testing.T) {
 	}
 }
 
+func TestDictionaryPenalty_Leetspeak(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	for _, password := range []string{"password", "P@ssw0rd", "5unsh1ne", "DR4G0N"} {
+		if penalty := calc.dictionaryPenalty(password); penalty != singleWordPenalty {
+			t.Errorf("dictionaryPenalty(%q) = %d, want %d", password, penalty, singleWordPenalty)
+		}
+	}
+	if penalty := calc.dictionaryPenalty("Xk9mQw2pRt"); penalty != 0 {
+		t.Errorf("dictionaryPenalty(random) = %d, want 0", penalty)
+	}
+}
+
+func TestDictionaryPenalty_Coverage(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	whole := calc.dictionaryPenalty("sunshine")
+	mostly := calc.dictionaryPenalty("sunshine42")
+	buried := calc.dictionaryPenalty("Xq7#sunshine9Kz!vR2m")
+	if !(whole > mostly && mostly > buried && buried > 0) {
+		t.Errorf("Expected penalty to shrink with coverage: whole=%d, mostly=%d, buried=%d", whole, mostly, buried)
+	}
+
+	_, wholeScore := calc.CalculateStrength("sunshine")
+	_, buriedScore := calc.CalculateStrength("Xq7#sunshine9Kz!vR2m")
+	if wholeScore >= buriedScore {
+		t.Errorf("Expected buried word to score higher: whole=%d, buried=%d", wholeScore, buriedScore)
+	}
+}
+
+func TestWithDictionary(t *testing.T) {
+	custom := NewPasswordStrengthCalculator(WithDictionary([]string{"Zorblax", "abc"}))
+
+	if penalty := custom.dictionaryPenalty("z0rbl4x"); penalty != singleWordPenalty {
+		t.Errorf("Custom word: penalty = %d, want %d", penalty, singleWordPenalty)
+	}
+	if penalty := NewPasswordStrengthCalculator().dictionaryPenalty("zorblax"); penalty != 0 {
+		t.Errorf("Custom word with default list: penalty = %d, want 0", penalty)
+	}
+	if penalty := custom.dictionaryPenalty("sunshine"); penalty != 0 {
+		t.Errorf("Default word with custom list: penalty = %d, want 0", penalty)
+	}
+	if penalty := custom.dictionaryPenalty("abc"); penalty != 0 {
+		t.Errorf("Word shorter than 4 characters: penalty = %d, want 0", penalty)
+	}
+
+	none := NewPasswordStrengthCalculator(WithDictionary(nil))
+	if penalty := none.dictionaryPenalty("password"); penalty != 0 {
+		t.Errorf("Empty dictionary: penalty = %d, want 0", penalty)
+	}
+}
+
 func TestHasSequentialChars(t *testing.T) {
 	calc := NewPasswordStrengthCalculator()
 
@@ -300,6 +584,10 @@ func TestHasSequentialChars(t *testing.T) {
 		{"has abc", "Pabc123", true},
 		{"has 123", "Pass123", true},
 		{"has 456", "My456Pass", true},
+		{"has 321", "Pass321word", true},
+		{"has cba", "cbaSecure", true},
+		{"has 9876", "My9876Pass", true},
+		{"mixed direction", "ab1ba", false},
 		{"too short", "ab", false},
 	}
 
@@ -337,3 +625,770 @@ func TestHasRepeatedChars(t *testing.T) {
 		})
 	}
 }
+
+func TestHasKeyboardPattern(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+
+	tests := []struct {
+		password string
+		want     bool
+	}{
+		{"asdfgh", true},
+		{"zxcvbn", true},
+		{"Qwertyuiop", true},
+		{"poiuy", true},    // Reverse
+		{"1qaz2wsx", true}, // Diagonal, across rows
+		{"zaq1", true},     // Diagonal, upwards
+		{"!@#$", true},     // Shifted number row
+		{"xx9ujmxx", true}, // Vertical, inside other characters
+		{"red", false},     // Neighboring keys, but not in one direction
+		{"qzwxec", false},
+		{"abc", false},   // Sequence, not a keyboard pattern
+		{"12345", false}, // Sequence on the number row, penalized as such
+		{"Xk9#mQ2$pR7!", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			if got := calc.hasKeyboardPattern(tt.password, 3); got != tt.want {
+				t.Errorf("hasKeyboardPattern(%q, 3) = %v, want %v (run %q)", tt.password, got, tt.want, keyboardRun(tt.password, 3))
+			}
+		})
+	}
+
+	// Penalized like sequential characters
+	_, without := calc.CalculateStrength("8mfk2pgw")
+	_, with := calc.CalculateStrength("1qaz2wsx")
+	if with >= without {
+		t.Errorf("Expected keyboard pattern to lower the score: without=%d, with=%d", without, with)
+	}
+	if got := calc.detectPatterns("1qaz2wsx"); got != 5 {
+		t.Errorf("detectPatterns(1qaz2wsx) = %d, want 5", got)
+	}
+}
+
+// roundTripFunc stubs an http.RoundTripper
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// breachStub returns a calculator whose breach check gets body with status, and the
+// requests it sent
+func breachStub(status int, body string) (*PasswordStrengthCalculator, *[]*http.Request) {
+	var requests []*http.Request
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req)
+		if err := req.Context().Err(); err != nil {
+			return nil, err // Like a real transport
+		}
+		return &http.Response{
+			StatusCode: status,
+			Status:     http.StatusText(status),
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	})}
+	return NewPasswordStrengthCalculator(WithHTTPClient(client)), &requests
+}
+
+func TestCheckBreached(t *testing.T) {
+	// SHA-1("password") = 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+	body := "0018A45C4D1DEF81644B54AB7F969B88D65:1\r\n" +
+		"1E4C9B93F3F0682250B6CF8331B7EE68FD8:9659365\r\n" +
+		"011053FD0102E94D6AE2F8B83D76FAF94F6:0\r\n" // Padding
+	calc, requests := breachStub(http.StatusOK, body)
+
+	count, err := calc.CheckBreached(context.Background(), "password")
+	if err != nil || count != 9659365 {
+		t.Fatalf("CheckBreached(password) = %d, %v, want 9659365, nil", count, err)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("Sent %d requests, want 1", len(*requests))
+	}
+	if got := (*requests)[0].URL.String(); got != breachRangeURL+"5BAA6" {
+		t.Errorf("Requested %q, want only the 5-character hash prefix", got)
+	}
+
+	// Suffix not in the response
+	count, err = calc.CheckBreached(context.Background(), "Xk9#mQ2$pR7!vB4@")
+	if err != nil || count != 0 {
+		t.Errorf("CheckBreached(unlisted) = %d, %v, want 0, nil", count, err)
+	}
+}
+
+func TestCheckBreached_TrimWhitespace(t *testing.T) {
+	var requested []string
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		requested = append(requested, req.URL.String())
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     http.StatusText(http.StatusOK),
+			Body:       io.NopCloser(strings.NewReader("1E4C9B93F3F0682250B6CF8331B7EE68FD8:9659365\r\n")),
+		}, nil
+	})}
+
+	// A pasted newline is trimmed before hashing, as for the score
+	calc := NewPasswordStrengthCalculatorWithConfig(StrengthConfig{TrimWhitespace: true}, WithHTTPClient(client))
+	count, err := calc.CheckBreached(context.Background(), " password\n")
+	if err != nil || count != 9659365 {
+		t.Errorf("CheckBreached(\" password\\n\") with TrimWhitespace = %d, %v, want 9659365, nil", count, err)
+	}
+
+	// Without TrimWhitespace the whitespace is part of the password
+	calc = NewPasswordStrengthCalculator(WithHTTPClient(client))
+	if count, err := calc.CheckBreached(context.Background(), " password\n"); err != nil || count != 0 {
+		t.Errorf("CheckBreached(\" password\\n\") = %d, %v, want 0, nil", count, err)
+	}
+	if len(requested) != 2 || requested[0] != breachRangeURL+"5BAA6" {
+		t.Errorf("Requested %v, want the prefix of SHA-1(\"password\") first", requested)
+	}
+}
+
+func TestCheckBreached_Errors(t *testing.T) {
+	calc, _ := breachStub(http.StatusServiceUnavailable, "")
+	if _, err := calc.CheckBreached(context.Background(), "password"); err == nil {
+		t.Error("Expected an error for a non-200 response")
+	}
+
+	calc, _ = breachStub(http.StatusOK, "1E4C9B93F3F0682250B6CF8331B7EE68FD8:many\r\n")
+	if _, err := calc.CheckBreached(context.Background(), "password"); err == nil {
+		t.Error("Expected an error for an invalid count")
+	}
+
+	calc, _ = breachStub(http.StatusOK, "")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := calc.CheckBreached(ctx, "password"); !errors.Is(err, context.Canceled) {
+		t.Errorf("CheckBreached with cancelled context: err = %v, want context.Canceled", err)
+	}
+}
+
+func TestWithThresholds(t *testing.T) {
+	banking := NewPasswordStrengthCalculator(WithThresholds(Thresholds{Weak: 25, Fair: 50, Good: 70, Strong: 85}))
+	defaults := NewPasswordStrengthCalculator()
+
+	// Same score, stricter level
+	defaultStrength, defaultScore := defaults.CalculateStrength("MyP@ssw0rd123")
+	bankingStrength, bankingScore := banking.CalculateStrength("MyP@ssw0rd123")
+	if bankingScore != defaultScore {
+		t.Errorf("Thresholds changed the score: %d vs %d", bankingScore, defaultScore)
+	}
+	if defaultStrength != StrengthStrong || bankingStrength != StrengthGood {
+		t.Errorf("Score %d: default = %v, banking = %v, want Strong and Good", defaultScore, defaultStrength, bankingStrength)
+	}
+
+	tests := []struct {
+		score int
+		want  PasswordStrength
+	}{
+		{0, StrengthVeryWeak}, {24, StrengthVeryWeak}, {25, StrengthWeak}, {50, StrengthFair},
+		{69, StrengthFair}, {70, StrengthGood}, {84, StrengthGood}, {85, StrengthStrong}, {100, StrengthStrong},
+	}
+	for _, tt := range tests {
+		if got := banking.scoreToStrength(tt.score); got != tt.want {
+			t.Errorf("scoreToStrength(%d) = %v, want %v", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestWithThresholds_InvalidFallsBack(t *testing.T) {
+	invalid := []Thresholds{
+		{},
+		{Weak: 35, Fair: 15, Good: 55, Strong: 75},  // Not increasing
+		{Weak: 15, Fair: 35, Good: 55, Strong: 55},  // Not strictly increasing
+		{Weak: 15, Fair: 35, Good: 55, Strong: 101}, // Out of range
+		{Weak: -5, Fair: 35, Good: 55, Strong: 75},
+	}
+
+	for _, thresholds := range invalid {
+		if thresholds.Valid() {
+			t.Errorf("%+v: Valid() = true, want false", thresholds)
+		}
+		calc := NewPasswordStrengthCalculator(WithThresholds(thresholds))
+		if calc.thresholds != DefaultThresholds() {
+			t.Errorf("%+v: thresholds = %+v, want defaults", thresholds, calc.thresholds)
+		}
+	}
+	if !DefaultThresholds().Valid() {
+		t.Error("DefaultThresholds() is not Valid")
+	}
+}
+
+// violatedRules returns the rules of violations
+func violatedRules(violations []PolicyViolation) []PolicyRule {
+	var rules []PolicyRule
+	for _, v := range violations {
+		rules = append(rules, v.Rule)
+	}
+	return rules
+}
+
+func TestPolicyValidate(t *testing.T) {
+	policy := Policy{
+		MinLength:     10,
+		MaxLength:     20,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MaxRepeatRun:  2,
+	}
+
+	tests := []struct {
+		password string
+		want     []PolicyRule
+	}{
+		{"Xk9#mQ2$pR7!", nil},
+		{"Xk9#mQ2", []PolicyRule{RuleMinLength}},
+		{"Xk9#mQ2$pR7!vB4@Xk9#mQ2$", []PolicyRule{RuleMaxLength}},
+		{"xk9#mq2$pr7!", []PolicyRule{RuleRequireUpper}},
+		{"XK9#MQ2$PR7!", []PolicyRule{RuleRequireLower}},
+		{"Xkq#mQz$pRt!", []PolicyRule{RuleRequireDigit}},
+		{"Xk9mQ2pR7vB4", []PolicyRule{RuleRequireSymbol}},
+		{"Xk9#mQ2$pR777", []PolicyRule{RuleMaxRepeatRun}},
+		{"", []PolicyRule{RuleMinLength, RuleRequireUpper, RuleRequireLower, RuleRequireDigit, RuleRequireSymbol}},
+		{"café🔒1A-xyz", nil}, // 11 characters, though more bytes
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			violations := policy.Validate(tt.password)
+			if got := violatedRules(violations); !slices.Equal(got, tt.want) {
+				t.Errorf("Validate(%q) rules = %v, want %v", tt.password, got, tt.want)
+			}
+			for _, v := range violations {
+				if v.Message == "" {
+					t.Errorf("%v violation has no message", v.Rule)
+				}
+			}
+		})
+	}
+
+	if violations := (Policy{}).Validate(""); violations != nil {
+		t.Errorf("Zero Policy: violations = %v, want none", violations)
+	}
+}
+
+func TestPolicyValidate_Forbidden(t *testing.T) {
+	email := "mquinn@example.com"
+	localPart, _, _ := strings.Cut(email, "@")
+	policy := Policy{MinLength: 12, Forbidden: []string{localPart, "", "Example"}}
+
+	// Strong, but contains the user's email local part
+	password := "Xk9#MQuinn$R7!vB"
+	if strength, score := NewPasswordStrengthCalculator().CalculateStrength(password); strength != StrengthStrong {
+		t.Fatalf("Test password should be Strong (got %v, %d)", strength, score)
+	}
+	violations := policy.Validate(password)
+	if got := violatedRules(violations); !slices.Equal(got, []PolicyRule{RuleForbidden}) {
+		t.Fatalf("Validate(%q) rules = %v, want [Forbidden]", password, got)
+	}
+	if !strings.Contains(violations[0].Message, "mquinn") {
+		t.Errorf("Message = %q, want it to name the forbidden text", violations[0].Message)
+	}
+
+	// Leetspeak doesn't hide it
+	if got := violatedRules(policy.Validate("Xk9#mQu1nn$R7!vB")); !slices.Equal(got, []PolicyRule{RuleForbidden}) {
+		t.Errorf("Leetspeak: rules = %v, want [Forbidden]", got)
+	}
+	if got := violatedRules(policy.Validate("Xk9#3x4mpl3$R7!vB")); !slices.Equal(got, []PolicyRule{RuleForbidden}) {
+		t.Errorf("Forbidden text is case-insensitive: rules = %v, want [Forbidden]", got)
+	}
+	if violations := policy.Validate("Xk9#mQ2$pR7!vB4@"); violations != nil {
+		t.Errorf("Unrelated password: violations = %v, want none", violations)
+	}
+}
+
+func TestPasswordStrength_AtLeast(t *testing.T) {
+	levels := []PasswordStrength{StrengthVeryWeak, StrengthWeak, StrengthFair, StrengthGood, StrengthStrong}
+
+	for i := 1; i < len(levels); i++ {
+		lower, higher := levels[i-1], levels[i]
+		if !higher.AtLeast(lower) {
+			t.Errorf("%v.AtLeast(%v) = false, want true", higher, lower)
+		}
+		if lower.AtLeast(higher) {
+			t.Errorf("%v.AtLeast(%v) = true, want false", lower, higher)
+		}
+	}
+	for _, level := range levels {
+		if !level.AtLeast(level) {
+			t.Errorf("%v.AtLeast(%v) = false, want true", level, level)
+		}
+	}
+}
+
+func TestMeetsMinimum(t *testing.T) {
+	calc := NewPasswordStrengthCalculator()
+	for _, password := range []string{"", "abc", "password123", "MyP@ssw0rd", "C0mpl3x!P@ssw0rd#2024"} {
+		strength, _ := calc.CalculateStrength(password)
+		for min := StrengthVeryWeak; min <= StrengthStrong; min++ {
+			if got, want := MeetsMinimum(password, min), strength >= min; got != want {
+				t.Errorf("MeetsMinimum(%q, %v) = %v, want %v (strength %v)", password, min, got, want, strength)
+			}
+		}
+	}
+}
+
+func TestStrengthMeter_MinimumStrength(t *testing.T) {
+	test.NewTempApp(t)
+	meter := NewPasswordStrengthMeter()
+
+	if !meter.MeetsMinimum() {
+		t.Error("Expected no requirement by default")
+	}
+
+	meter.SetMinimumStrength(StrengthGood)
+	meter.UpdatePassword("abc")
+	if meter.MeetsMinimum() {
+		t.Error("Expected weak password not to meet Good minimum")
+	}
+	if want := "Password Strength: " + meter.GetStrength().String() + " (minimum: Good)"; meter.labelWidget.Text != want {
+		t.Errorf("Label = %q, want %q", meter.labelWidget.Text, want)
+	}
+
+	meter.UpdatePassword("C0mpl3x!P@ssw0rd#2024")
+	if !meter.MeetsMinimum() {
+		t.Errorf("Expected %v password to meet Good minimum", meter.GetStrength())
+	}
+	if meter.labelWidget.Text != "Password Strength: Strong" {
+		t.Errorf("Label = %q, want requirement hidden once met", meter.labelWidget.Text)
+	}
+}
+
+func TestStrengthMeter_PrivacyMode(t *testing.T) {
+	test.NewTempApp(t)
+	meter := NewPasswordStrengthMeter()
+
+	// Two Weak passwords with different scores
+	meter.UpdatePassword("abcdef")
+	shortScore, shortWidth := meter.GetScore(), meter.strengthBar.MinSize().Width
+	meter.UpdatePassword("qzwxec")
+	if meter.GetStrength() != StrengthWeak || shortScore == meter.GetScore() {
+		t.Fatalf("Test passwords should both be Weak with different scores (got %v, %d vs %d)", meter.GetStrength(), shortScore, meter.GetScore())
+	}
+	if meter.strengthBar.MinSize().Width == shortWidth {
+		t.Error("Expected score-proportional widths to differ without privacy mode")
+	}
+
+	meter.SetPrivacyMode(true)
+	longWidth := meter.strengthBar.MinSize().Width
+	meter.UpdatePassword("abcdef")
+	if got := meter.strengthBar.MinSize().Width; got != longWidth {
+		t.Errorf("Privacy mode: expected same width for same level, got %v and %v", got, longWidth)
+	}
+	if meter.GetScore() != shortScore {
+		t.Errorf("GetScore() = %d, want real score %d in privacy mode", meter.GetScore(), shortScore)
+	}
+
+	// Each level has its own width
+	widths := map[float32]PasswordStrength{}
+	for _, password := range []string{"", "abcdef", "password123", "MyP@ssw0rd", "C0mpl3x!P@ssw0rd#2024"} {
+		meter.UpdatePassword(password)
+		width := meter.strengthBar.MinSize().Width
+		if level, seen := widths[width]; seen && level != meter.GetStrength() {
+			t.Errorf("Levels %v and %v share width %v", level, meter.GetStrength(), width)
+		}
+		widths[width] = meter.GetStrength()
+	}
+}
+
+func TestStrengthMeter_PrivacyModeHidesDetails(t *testing.T) {
+	test.NewTempApp(t)
+	meter := NewPasswordStrengthMeter()
+	meter.SetShowDetails(true)
+	meter.UpdatePassword("abcdef")
+	if !strings.Contains(meter.labelWidget.Text, "/100)") {
+		t.Fatalf("Label = %q, want details without privacy mode", meter.labelWidget.Text)
+	}
+
+	// Score and crack time both reveal the length
+	meter.SetPrivacyMode(true)
+	if meter.labelWidget.Text != "Password Strength: Weak" {
+		t.Errorf("Label = %q, want only the level in privacy mode", meter.labelWidget.Text)
+	}
+	meter.UpdatePassword("qzwxec")
+	if meter.labelWidget.Text != "Password Strength: Weak" {
+		t.Errorf("Label = %q, want only the level in privacy mode", meter.labelWidget.Text)
+	}
+
+	meter.SetPrivacyMode(false)
+	if !strings.Contains(meter.labelWidget.Text, "/100)") {
+		t.Errorf("Label = %q, want details back after privacy mode", meter.labelWidget.Text)
+	}
+}
+
+func TestStrengthMeter_CustomCalculator(t *testing.T) {
+	test.NewTempApp(t)
+	// Trailing whitespace lifts this password to Strong with the default calculator
+	const padded = "Xk9mQw2p  \n"
+	strict := NewPasswordStrengthCalculatorWithConfig(StrengthConfig{TrimWhitespace: true})
+
+	meter := NewPasswordStrengthMeter()
+	meter.UpdatePassword(padded)
+	if meter.GetStrength() != StrengthStrong {
+		t.Fatalf("Default calculator: strength = %v, want Strong", meter.GetStrength())
+	}
+
+	// SetCalculator re-scores the current password
+	meter.SetCalculator(strict)
+	if meter.GetStrength() != StrengthGood {
+		t.Errorf("After SetCalculator: strength = %v, want Good", meter.GetStrength())
+	}
+	if meter.labelWidget.Text != "Password Strength: Good" {
+		t.Errorf("Label = %q, want the custom calculator's level", meter.labelWidget.Text)
+	}
+
+	custom := NewPasswordStrengthMeterWithCalculator(strict)
+	custom.UpdatePassword(padded)
+	if custom.GetStrength() != StrengthGood {
+		t.Errorf("NewPasswordStrengthMeterWithCalculator: strength = %v, want Good", custom.GetStrength())
+	}
+
+	// nil restores the default calculator
+	meter.SetCalculator(nil)
+	if meter.GetStrength() != StrengthStrong {
+		t.Errorf("After SetCalculator(nil): strength = %v, want Strong", meter.GetStrength())
+	}
+}
+
+func TestStrengthMeter_SetCalculatorUpdatesColor(t *testing.T) {
+	test.NewTempApp(t)
+	const text = "MyP@ssw0rd123" // Strong by default, Good with stricter thresholds
+	banking := NewPasswordStrengthCalculator(WithThresholds(Thresholds{Weak: 25, Fair: 50, Good: 70, Strong: 85}))
+
+	for _, meter := range []*PasswordStrengthMeter{NewPasswordStrengthMeter(), NewSegmentedStrengthMeter(5)} {
+		var changes []PasswordStrength
+		meter.OnStrengthChanged = func(strength PasswordStrength, _ int) { changes = append(changes, strength) }
+		meter.UpdatePassword(text)
+		strongColor := meter.strengthBar.FillColor
+
+		meter.SetCalculator(banking)
+		if meter.GetStrength() != StrengthGood {
+			t.Fatalf("After SetCalculator: strength = %v, want Good", meter.GetStrength())
+		}
+		if meter.strengthBar.FillColor == strongColor {
+			t.Errorf("Bar color still %v after the level changed", strongColor)
+		}
+		if !slices.Equal(changes, []PasswordStrength{StrengthStrong, StrengthGood}) {
+			t.Errorf("OnStrengthChanged levels = %v, want [Strong Good]", changes)
+		}
+		if meter.segments != nil {
+			if filled := meter.filledSegments(); filled != 4 || meter.segments[3].FillColor != meter.strengthBar.FillColor || meter.segments[4].FillColor != emptySegmentColor {
+				t.Errorf("Segments not updated for Good: %d filled", filled)
+			}
+		}
+	}
+}
+
+func TestStrengthMeter_DistinctLevelColors(t *testing.T) {
+	test.NewTempApp(t)
+	meter := NewPasswordStrengthMeter()
+
+	seen := make(map[color.Color]PasswordStrength)
+	for level := StrengthVeryWeak; level <= StrengthStrong; level++ {
+		meter.strength = level
+		meter.updateAppearance()
+		barColor := meter.strengthBar.FillColor
+		if other, ok := seen[barColor]; ok {
+			t.Errorf("%v and %v share bar color %v", other, level, barColor)
+		}
+		seen[barColor] = level
+	}
+}
+
+func TestStrengthMeter_OnStrengthChanged(t *testing.T) {
+	test.NewTempApp(t)
+	meter := NewPasswordStrengthMeter()
+
+	type change struct {
+		strength PasswordStrength
+		score    int
+	}
+	var changes []change
+	meter.OnStrengthChanged = func(strength PasswordStrength, score int) {
+		changes = append(changes, change{strength, score})
+	}
+
+	// The meter starts Very Weak; clearing it again is no change
+	meter.UpdatePassword("")
+	if len(changes) != 0 {
+		t.Fatalf("Fired without a level change: %v", changes)
+	}
+
+	// Typing that stays at one level fires once
+	meter.UpdatePassword("Xk9#mQ2$pR7!")
+	meter.UpdatePassword("Xk9#mQ2$pR7!v")
+	if len(changes) != 1 || changes[0].strength != StrengthStrong {
+		t.Fatalf("Changes = %v, want one change to Strong", changes)
+	}
+	if score := NewPasswordStrengthCalculator().Analyze("Xk9#mQ2$pR7!").Score; changes[0].score != score {
+		t.Errorf("Reported score %d, want %d", changes[0].score, score)
+	}
+
+	meter.UpdatePassword("")
+	if len(changes) != 2 || changes[1] != (change{StrengthVeryWeak, 0}) {
+		t.Errorf("Changes = %v, want a second change back to Very Weak", changes)
+	}
+}
+
+func TestSegmentedStrengthMeter(t *testing.T) {
+	test.NewTempApp(t)
+	meter := NewSegmentedStrengthMeter(5)
+
+	passwords := map[PasswordStrength]string{
+		StrengthVeryWeak: "",
+		StrengthWeak:     "abcdef",
+		StrengthFair:     "Password1",
+		StrengthGood:     "Xk9mQw2pRt",
+		StrengthStrong:   "Xk9#mQ2$pR7!",
+	}
+	for level := StrengthVeryWeak; level <= StrengthStrong; level++ {
+		meter.UpdatePassword(passwords[level])
+		if meter.GetStrength() != level {
+			t.Fatalf("%q: strength = %v, want %v", passwords[level], meter.GetStrength(), level)
+		}
+
+		// Level n fills n+1 segments in the level's color
+		for i, segment := range meter.segments {
+			want := emptySegmentColor
+			if i <= int(level) {
+				want = meter.strengthBar.FillColor
+			}
+			if segment.FillColor != want {
+				t.Errorf("%v: segment %d color = %v, want %v", level, i, segment.FillColor, want)
+			}
+		}
+	}
+	if meter.GetScore() != NewPasswordStrengthCalculator().Analyze(passwords[StrengthStrong]).Score {
+		t.Errorf("GetScore() = %d, want the calculator's score", meter.GetScore())
+	}
+}
+
+func TestSegmentedStrengthMeter_SegmentCounts(t *testing.T) {
+	test.NewTempApp(t)
+
+	tests := []struct {
+		segments int
+		want     []int // Filled segments from Very Weak to Strong
+	}{
+		{5, []int{1, 2, 3, 4, 5}},
+		{3, []int{1, 2, 2, 3, 3}},
+		{10, []int{1, 3, 6, 8, 10}},
+		{1, []int{1, 1, 1, 1, 1}},
+		{0, []int{1, 2, 3, 4, 5}}, // Default
+	}
+	for _, tt := range tests {
+		meter := NewSegmentedStrengthMeter(tt.segments)
+		for level := StrengthVeryWeak; level <= StrengthStrong; level++ {
+			meter.strength = level
+			if got := meter.filledSegments(); got != tt.want[level] {
+				t.Errorf("%d segments, %v: filled = %d, want %d", tt.segments, level, got, tt.want[level])
+			}
+		}
+	}
+}
+
+func TestEstimateCrackTime(t *testing.T) {
+	tests := []struct {
+		bits float64
+		want string
+	}{
+		{0, "instantly"},
+		{30, "instantly"},
+		{40, "seconds"},
+		{45, "minutes"},
+		{50, "hours"},
+		{55, "days"},
+		{58, "months"},
+		{65, "years"},
+		{75, "centuries"},
+		{200, "centuries"},
+	}
+	for _, tt := range tests {
+		if got := EstimateCrackTime(tt.bits); got != tt.want {
+			t.Errorf("EstimateCrackTime(%v) = %q, want %q", tt.bits, got, tt.want)
+		}
+	}
+}
+
+func TestStrengthMeter_ShowDetails(t *testing.T) {
+	test.NewTempApp(t)
+	meter := NewPasswordStrengthMeter()
+	meter.UpdatePassword("Xk9#mQ2$pR7!")
+	if meter.labelWidget.Text != "Password Strength: Strong" {
+		t.Errorf("Label = %q, want no details by default", meter.labelWidget.Text)
+	}
+
+	meter.SetShowDetails(true)
+	analysis := NewPasswordStrengthCalculator().Analyze("Xk9#mQ2$pR7!")
+	want := fmt.Sprintf("Password Strength: Strong (%d/100) — %s", analysis.Score, analysis.CrackTime)
+	if meter.labelWidget.Text != want {
+		t.Errorf("Label = %q, want %q", meter.labelWidget.Text, want)
+	}
+	if analysis.CrackTime != "centuries" {
+		t.Errorf("CrackTime = %q, want centuries", analysis.CrackTime)
+	}
+
+	// No crack time for an empty password
+	meter.UpdatePassword("")
+	if meter.labelWidget.Text != "Password Strength: Very Weak (0/100)" {
+		t.Errorf("Empty password: label = %q", meter.labelWidget.Text)
+	}
+
+	meter.SetShowDetails(false)
+	if meter.labelWidget.Text != "Password Strength: Very Weak" {
+		t.Errorf("Label = %q, want details hidden again", meter.labelWidget.Text)
+	}
+}
+
+func TestStrengthMeter_SetColors(t *testing.T) {
+	test.NewTempApp(t)
+	meter := NewPasswordStrengthMeter()
+	meter.UpdatePassword("Xk9#mQ2$pR7!")
+	if meter.GetStrength() != StrengthStrong {
+		t.Fatalf("Test password should be Strong (got %v)", meter.GetStrength())
+	}
+
+	blue := color.RGBA{R: 0, G: 90, B: 200, A: 255}
+	colors := map[PasswordStrength]color.Color{StrengthStrong: blue}
+	meter.SetColors(colors)
+	if meter.strengthBar.FillColor != blue {
+		t.Errorf("Strong bar color = %v, want %v immediately", meter.strengthBar.FillColor, blue)
+	}
+
+	// Other levels keep their defaults, and the caller's map is copied
+	colors[StrengthWeak] = blue
+	meter.UpdatePassword("abcdef")
+	if meter.GetStrength() != StrengthWeak || meter.strengthBar.FillColor != defaultStrengthColors[StrengthWeak] {
+		t.Errorf("Weak bar color = %v, want default %v", meter.strengthBar.FillColor, defaultStrengthColors[StrengthWeak])
+	}
+
+	// nil restores the defaults
+	meter.UpdatePassword("Xk9#mQ2$pR7!")
+	meter.SetColors(nil)
+	if meter.strengthBar.FillColor != defaultStrengthColors[StrengthStrong] {
+		t.Errorf("After SetColors(nil): color = %v, want default", meter.strengthBar.FillColor)
+	}
+
+	segmented := NewSegmentedStrengthMeter(5)
+	segmented.SetColors(map[PasswordStrength]color.Color{StrengthVeryWeak: blue})
+	if segmented.segments[0].FillColor != blue {
+		t.Errorf("Segment color = %v, want %v", segmented.segments[0].FillColor, blue)
+	}
+}
+
+func TestPasswordRequirementsList(t *testing.T) {
+	test.NewTempApp(t)
+	policy := Policy{MinLength: 12, RequireUpper: true, RequireDigit: true, RequireSymbol: true, Forbidden: []string{"MQuinn", " "}}
+	list := NewPasswordRequirementsList(policy)
+
+	wantLabels := []string{"At least 12 characters", "An uppercase letter", "A number", "A symbol", "Doesn't contain 'mquinn'"}
+	if len(list.labels) != len(wantLabels) {
+		t.Fatalf("%d rows, want %d (one per rule the policy turns on)", len(list.labels), len(wantLabels))
+	}
+	for i, label := range list.labels {
+		if label.Text != wantLabels[i] {
+			t.Errorf("Row %d = %q, want %q", i, label.Text, wantLabels[i])
+		}
+	}
+
+	// Rows follow Policy.Validate as the user types
+	for _, text := range []string{"", "abc", "Abc1", "Abc1!", "Abc1!mquinn99", "Xk9#mQ2$pR7!"} {
+		list.UpdatePassword(text)
+		violations := policy.Validate(text)
+		if list.AllMet() != (len(violations) == 0) {
+			t.Errorf("%q: AllMet() = %v with %d violations", text, list.AllMet(), len(violations))
+		}
+		if !slices.Equal(list.Violations(), violations) {
+			t.Errorf("%q: Violations() = %v, want %v", text, list.Violations(), violations)
+		}
+		for i, rule := range list.rules {
+			met := !slices.Contains(violatedRules(violations), rule)
+			wantIcon := requirementUnmetIcon
+			if met {
+				wantIcon = requirementMetIcon
+			}
+			if list.icons[i].Resource != wantIcon {
+				t.Errorf("%q: %v row shows met=%v, want %v", text, rule, !met, met)
+			}
+		}
+	}
+	if !list.AllMet() {
+		t.Error("Expected the last password to meet every rule")
+	}
+}
+
+func TestGeneratePassword(t *testing.T) {
+	policy := Policy{
+		MinLength:     12,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MaxRepeatRun:  2,
+		Forbidden:     []string{"mquinn"},
+	}
+	calc := NewPasswordStrengthCalculator()
+
+	seen := make(map[string]bool)
+	for range 20 {
+		generated, err := GeneratePassword(policy, StrengthStrong)
+		if err != nil {
+			t.Fatalf("GeneratePassword: %v", err)
+		}
+		if len(generated) != generatedLength {
+			t.Errorf("%q: length %d, want %d", generated, len(generated), generatedLength)
+		}
+		if violations := policy.Validate(generated); violations != nil {
+			t.Errorf("%q violates the policy: %v", generated, violations)
+		}
+		a := calc.Analyze(generated)
+		if a.Strength != StrengthStrong || a.DictionaryPenalty != 0 || a.PatternPenalty != 0 {
+			t.Errorf("%q: %v, dictionary penalty %d, pattern penalty %d", generated, a.Strength, a.DictionaryPenalty, a.PatternPenalty)
+		}
+		seen[generated] = true
+	}
+	if len(seen) < 20 {
+		t.Errorf("Generated %d distinct passwords out of 20", len(seen))
+	}
+
+	// Length follows the policy
+	if generated, err := GeneratePassword(Policy{MinLength: 24}, StrengthGood); err != nil || len(generated) != 24 {
+		t.Errorf("MinLength 24: %q, %v", generated, err)
+	}
+	if generated, err := GeneratePassword(Policy{MaxLength: 10}, StrengthFair); err != nil || len(generated) != 10 {
+		t.Errorf("MaxLength 10: %q, %v", generated, err)
+	}
+
+	// Characters forbidden on their own are never used
+	digits := []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	generated, err := GeneratePassword(Policy{Forbidden: digits}, StrengthGood)
+	if err != nil || strings.ContainsAny(generated, "0123456789") {
+		t.Errorf("Digits forbidden: %q, %v", generated, err)
+	}
+}
+
+func TestGeneratePassword_Impossible(t *testing.T) {
+	digits := []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
+	impossible := map[string]Policy{
+		"min above max":         {MinLength: 20, MaxLength: 10},
+		"too short for classes": {MaxLength: 3, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true},
+		"required class forbidden": {
+			MinLength: 4, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true, Forbidden: digits,
+		},
+		"everything forbidden": {Forbidden: strings.Split(generatorLowercase+generatorDigits+generatorSymbols, "")},
+	}
+	for name, policy := range impossible {
+		if generated, err := GeneratePassword(policy, StrengthVeryWeak); !errors.Is(err, ErrImpossiblePolicy) {
+			t.Errorf("%s: GeneratePassword = %q, %v, want ErrImpossiblePolicy", name, generated, err)
+		}
+	}
+
+	// Possible policy, unreachable target
+	generated, err := GeneratePassword(Policy{MaxLength: 6}, StrengthStrong)
+	if err == nil || errors.Is(err, ErrImpossiblePolicy) {
+		t.Errorf("Short MaxLength with Strong target: %q, %v, want a non-policy error", generated, err)
+	}
+}
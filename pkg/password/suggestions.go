@@ -0,0 +1,76 @@
+package password
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// suggestedLength is the length Suggestions asks for
+const suggestedLength = 12
+
+// Suggestions returns tips for making password stronger, such as "Add a symbol" or
+// "Avoid the common word 'password'". Each tip comes from a check that lowered the
+// score, so following it raises the score. Returns "Enter a password" for an empty
+// password, and an empty slice when there is nothing to improve.
+func (c *PasswordStrengthCalculator) Suggestions(password string) []string {
+	if c.config.TrimWhitespace {
+		password = strings.TrimSpace(password)
+	}
+	if password == "" {
+		return []string{"Enter a password"}
+	}
+
+	a := c.Analyze(password)
+	suggestions := []string{}
+
+	if a.Length < suggestedLength {
+		suggestions = append(suggestions, fmt.Sprintf("Make it at least %d characters", suggestedLength))
+	}
+	if !a.HasLower {
+		suggestions = append(suggestions, "Add a lowercase letter")
+	}
+	if !a.HasUpper {
+		suggestions = append(suggestions, "Add an uppercase letter")
+	}
+	if !a.HasDigit {
+		suggestions = append(suggestions, "Add a number")
+	}
+	if !a.HasSymbol {
+		suggestions = append(suggestions, "Add a symbol")
+	}
+
+	// Common patterns and dictionary words; "password" is both, but is mentioned once
+	var words []string
+	if pattern := commonPattern(password); pattern != "" {
+		if strings.IndexFunc(pattern, unicode.IsLetter) < 0 {
+			suggestions = append(suggestions, fmt.Sprintf("Avoid common number patterns like '%s'", pattern))
+		} else {
+			words = append(words, pattern)
+		}
+	}
+	_, found := c.dictionaryMatch(password)
+	for _, word := range found {
+		if !slices.ContainsFunc(words, func(w string) bool { return strings.Contains(w, word) || strings.Contains(word, w) }) {
+			words = append(words, word)
+		}
+	}
+	for _, word := range words {
+		suggestions = append(suggestions, fmt.Sprintf("Avoid the common word '%s'", word))
+	}
+
+	if run := sequentialRun(password, 3); run != "" {
+		suggestions = append(suggestions, fmt.Sprintf("Avoid sequences like '%s'", run))
+	}
+	if run := keyboardRun(password, 3); run != "" {
+		suggestions = append(suggestions, fmt.Sprintf("Avoid keyboard patterns like '%s'", run))
+	}
+	if run := repeatedRun(password, 3); run != "" {
+		suggestions = append(suggestions, fmt.Sprintf("Avoid repeated characters like '%s'", run))
+	} else if a.UniqueRatio < 0.8 {
+		suggestions = append(suggestions, "Use more different characters")
+	}
+
+	return suggestions
+}
@@ -0,0 +1,81 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Accessibility support
+//
+// Fyne does not yet expose an accessibility tree, so the table builds plain-text
+// descriptions of its selection that applications can forward to assistive
+// technology (a screen-reader bridge, text-to-speech, or a visible live-region
+// label). AccessibleDescription can be queried at any time, and
+// Config.OnAccessibilityAnnouncement is called whenever the selection changes.
+// When Fyne gains accessibility APIs, these descriptions are the text the table
+// will report for its focused cell.
+
+// AccessibleDescription returns a description of the current selection suitable
+// for screen readers, e.g. "Row 3, Status, In Progress". Rows are numbered by
+// their position in the current view (1-based, header excluded).
+func (st *Table) AccessibleDescription() string {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	// Multi-select: summarize the selected rows
+	if count := len(st.state.selectedRows); count > 1 {
+		return fmt.Sprintf("%d rows selected", count)
+	}
+
+	row := st.state.selectedRow
+	if row < 0 || row >= len(st.data) {
+		return "No selection"
+	}
+
+	parts := []string{fmt.Sprintf("Row %d", st.accessibleRowNumber(row))}
+
+	col := st.state.selectedCol
+	if col >= 0 && col < len(st.config.Columns) && !st.config.Columns[col].Hidden {
+		column := st.config.Columns[col]
+		title := column.Title
+		if title == "" {
+			title = column.ID
+		}
+		parts = append(parts, title, st.accessibleCellValue(st.data[row], column))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// accessibleRowNumber returns the 1-based position of a data row among the visible rows
+// (group header rows excluded), or the data index + 1 if the row is not visible
+func (st *Table) accessibleRowNumber(dataIndex int) int {
+	if pos := indexOf(st.navigableRows(), dataIndex); pos >= 0 {
+		return pos + 1
+	}
+	return dataIndex + 1
+}
+
+// accessibleCellValue returns the text a cell displays, or "blank" for empty cells
+func (st *Table) accessibleCellValue(item interface{}, col ColumnConfig) string {
+	var value string
+	if col.GetCellValue != nil {
+		value = col.GetCellValue(item)
+	} else if b, ok := st.boolFieldValue(item, col); ok && col.Formatter == nil && st.boolDisplay(col) == BoolDisplayIcon {
+		value = boolDisplayText(b, BoolDisplayYesNo) // Read ✓/✗ as words
+	} else {
+		value = st.displayValue(item, col)
+	}
+	if strings.TrimSpace(value) == "" {
+		return "blank"
+	}
+	return value
+}
+
+// announceSelection reports the current selection to Config.OnAccessibilityAnnouncement
+func (st *Table) announceSelection() {
+	if st.config.OnAccessibilityAnnouncement == nil {
+		return
+	}
+	st.config.OnAccessibilityAnnouncement(st.AccessibleDescription())
+}
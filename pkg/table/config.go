@@ -3,9 +3,13 @@ package table
 import (
 	"fmt"
 	"image/color"
+	"maps"
+	"math"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"fyne.io/fyne/v2"
 )
@@ -19,6 +23,88 @@ const (
 	AlignRight
 )
 
+// FilterOperator specifies how a column filter value is matched against a cell value
+type FilterOperator int
+
+const (
+	FilterContains   FilterOperator = iota // Cell value contains the filter value
+	FilterEquals                           // Cell value equals the filter value
+	FilterStartsWith                       // Cell value starts with the filter value
+	FilterEndsWith                         // Cell value ends with the filter value
+	FilterRegex                            // Cell value matches the filter value as a regex
+)
+
+// FilterMode specifies how plain-text search text is matched against rows
+type FilterMode int
+
+const (
+	FilterPhrase FilterMode = iota // The whole search text is one substring (default)
+	FilterAnd                      // Every whitespace-separated term must match a search column
+	FilterOr                       // Any term may match; "quoted phrases" are one term in both modes
+	FilterFuzzy                    // The search text's characters must appear in order, e.g. "prjalpha" finds "Project Alpha"
+)
+
+// BoolDisplay specifies how the default renderer shows bool field values
+type BoolDisplay int
+
+const (
+	BoolDisplayDefault BoolDisplay = iota // Text, or Icon when Config.BoolAsIcon is set
+	BoolDisplayText                       // "true" / "false"
+	BoolDisplayIcon                       // "✓" / "✗"
+	BoolDisplayYesNo                      // "Yes" / "No"
+	BoolDisplayOnOff                      // "On" / "Off"
+)
+
+// EditType specifies the widget an Editable column is edited with
+type EditType int
+
+const (
+	EditText   EditType = iota // Text entry accepting any text
+	EditNumber                 // Text entry accepting only numbers, e.g. "42", "-1.5" or "1e3" (not NaN, Inf or hex); other text keeps the edit open
+	EditBool                   // Check, saved as "true" / "false" when toggled (Space/Enter toggles)
+	EditSelect                 // Select box of the column's PopupOptions, saved when an option is chosen
+)
+
+// InitialSelection specifies which row SetData selects when data is loaded
+type InitialSelection int
+
+const (
+	InitialSelectionNone     InitialSelection = iota // Nothing is selected (unless SelectFirstCellOnStartup is set)
+	InitialSelectionFirstRow                         // First shown row
+	InitialSelectionLastRow                          // Last shown row, e.g. for logs that append at the bottom
+	InitialSelectionByKey                            // Row whose Config.RowKey equals Config.InitialSelectionKey
+)
+
+// SortCycle specifies how repeated header clicks move through sort states
+type SortCycle int
+
+const (
+	SortCycleTwoState   SortCycle = iota // Clicks toggle ascending ↔ descending
+	SortCycleThreeState                  // Clicks cycle ascending → descending → unsorted (original order)
+)
+
+// Density selects preset row heights and font size (see Config.Density)
+type Density int
+
+const (
+	DensityComfortable Density = iota // 35px rows, 30px header, theme font size
+	DensityCompact                    // 24px rows and header, 11pt font, e.g. for dashboards
+	DensitySpacious                   // 44px rows, 36px header, 16pt font
+)
+
+// densityPreset holds the sizes a Density stands for
+type densityPreset struct {
+	rowHeight    float32
+	headerHeight float32
+	fontSize     float32 // 0 = theme text size
+}
+
+var densityPresets = map[Density]densityPreset{
+	DensityComfortable: {rowHeight: 35, headerHeight: 30},
+	DensityCompact:     {rowHeight: 24, headerHeight: 24, fontSize: 11},
+	DensitySpacious:    {rowHeight: 44, headerHeight: 36, fontSize: 16},
+}
+
 // TreeIconTheme defines the visual style for tree hierarchy indicators
 type TreeIconTheme struct {
 	Name   string
@@ -86,17 +172,26 @@ type ColumnConfig struct {
 	MinWidth float32
 
 	// Behavior flags
-	Sortable bool // true = clickable header for sorting
-	Editable bool // true = inline editing enabled
-	ReadOnly bool // true = prevent keyboard activation
-	Hidden   bool // true = column is hidden by default
+	Sortable bool     // true = clickable header for sorting
+	Editable bool     // true = inline editing enabled
+	EditType EditType // Widget used for inline editing (default: EditText); OnCellEdited receives its value as text
+	ReadOnly bool     // true = prevent keyboard activation
+	Hidden   bool     // true = column is hidden by default
 
 	// Visual styling
-	Alignment TextAlignment // Text alignment (default: AlignLeft)
+	Alignment        TextAlignment // Text alignment (default: AlignLeft)
+	EmptyPlaceholder string        // Muted text the default renderer shows for empty values (e.g. "—"); sorting/filtering still see empty
+	HeaderTooltip    string        // Help shown when hovering the header, e.g. "External task" for "Ext." (empty = none)
+	BoolDisplay      BoolDisplay   // How bool values are shown when Formatter is nil (default: text, or icons with Config.BoolAsIcon); sorting/filtering use the bool
+
+	// Tree hierarchy
+	TreeColumn bool // true = default renderer draws indentation and a clickable ▶/▼ expand toggle
 
 	// Custom rendering and logic
-	Renderer   CellRenderer   // Custom cell content renderer
-	Comparator SortComparator // Custom sort logic (nil = default string compare)
+	Renderer   CellRenderer                   // Custom cell content renderer
+	Comparator SortComparator                 // Custom sort logic (nil = default string compare)
+	Formatter  func(value interface{}) string // Display text for the raw field value (nil = fmt %v); sorting/filtering use the raw value
+	SortKey    func(data interface{}) string  // Sort key for the row when Comparator is nil (e.g. last name for a "Mr. Smith" display); compared as strings
 
 	// Popup menu for interactive cells
 	PopupOptions    func(data interface{}) []string                            // Returns menu options for SPACE activation
@@ -127,34 +222,77 @@ type CellRenderer func(data interface{}, container fyne.CanvasObject, rowIndex i
 //   - negative if a < b
 //   - zero if a == b
 //   - positive if a > b
+//   - SortFirst / SortLast to place a before / after b in both sort directions
 type SortComparator func(a, b interface{}) int
 
+// Comparator results that ignore the sort direction (see NilsLast)
+const (
+	SortFirst = math.MinInt // a sorts before b, ascending or descending
+	SortLast  = math.MaxInt // a sorts after b, ascending or descending
+)
+
+// SortKey is one column of a multi-column sort
+type SortKey struct {
+	ColumnID  string // Column to sort by
+	Ascending bool   // true = ascending, false = descending
+}
+
+// SortSpec is a multi-column sort, highest priority first: each key only orders rows
+// that all earlier keys consider equal (e.g. by status, then by priority)
+type SortSpec []SortKey
+
 // Config defines the structure and behavior of a sortable table
 type Config struct {
 	// Core settings
 	ID           string         // Unique identifier for persistence
 	Columns      []ColumnConfig // Column definitions
-	RowHeight    float32        // Default: 35px
-	HeaderHeight float32        // Default: 30px
+	RowHeight    float32        // Minimum data row height (0 = from Density, default: 35px)
+	HeaderHeight float32        // Header row height (0 = from Density, default: 30px)
+	Density      Density        // Preset row/header heights and font size; non-zero RowHeight, HeaderHeight and FontSize override it
 
 	// Features
-	AllowMultiSelect  bool          // true = multi-select, false = single-select
-	ShowSearch        bool          // true = show search box above table
-	SearchPlaceholder string        // Search box placeholder text
-	FilterTitle       string        // Card title for filter section (default: "Search/Filter")
-	ShowToolbar       bool          // true = show toolbar with bulk actions
-	TreeIconTheme     TreeIconTheme // Visual style for hierarchical indicators
-	ShowBranch        bool          // true = show branch character (├), false = hide it
-	RowSelectOnlyMode bool          // true = arrow keys select rows only, false = select row+column
+	AllowMultiSelect  bool           // true = multi-select, false = single-select
+	ShowSearch        bool           // true = show search box above table
+	SearchPlaceholder string         // Search box placeholder text
+	FilterTitle       string         // Card title for filter section (default: "Search/Filter")
+	ShowToolbar       bool           // true = show toolbar with bulk actions
+	ShowRowCount      bool           // true = show "Showing X of Y rows" status line below the table
+	TreeIconTheme     TreeIconTheme  // Visual style for hierarchical indicators
+	ShowBranch        bool           // true = show branch character (├), false = hide it
+	RowSelectOnlyMode bool           // true = arrow keys select rows only, false = select row+column
+	HighlightFullRow  bool           // Row-column mode only: true = tint the whole selected row and outline the active cell
+	TabMovesFocusOut  bool           // true = Tab always leaves the table, false = Tab moves between cells first
+	FocusNext         fyne.Focusable // Widget focused when Tab leaves the table forwards (nil = Fyne's focus order)
+
+	// Click Behavior
+	ActivateOnSingleClick       bool // true = single click toggles checkboxes/opens popups, false = click only selects (activate via Space/Enter or double-click) (NewConfig default: true)
+	PlainClickReplacesSelection bool // Multi-select only: true = plain click replaces the selection and Ctrl/Cmd-click toggles, false = every click toggles (NewConfig default: true)
+	TypeToEdit                  bool // true = typing a character on a selected editable cell starts editing with that character (NewConfig default: true)
+	SelectableCells             bool // true = text in the selected cell(s) can be selected with the mouse and copied (default renderer, FontSize 0)
 
 	// Column Resizing
 	EnableDoubleClickResize bool // true = double-click column divider to auto-resize
+	EnableManualResize      bool // true = drag column dividers even with headers hidden (adds a thin divider strip above a headerless table)
 
 	// Header Control
-	ShowHeaders bool // true = show column headers (also enables manual drag-resize), false = hide headers
+	ShowHeaders         bool      // true = show column headers (also enables manual drag-resize), false = hide headers (see EnableManualResize)
+	SortAscIndicator    string    // Text shown in the sorted column's header when ascending (default: "▲", empty = none)
+	SortDescIndicator   string    // Text shown in the sorted column's header when descending (default: "▼", empty = none)
+	SortIndicatorAsIcon bool      // true = show the sort direction as a theme icon beside the title instead of indicator text
+	HeaderSortCycle     SortCycle // Sort states a sortable header click cycles through (default: SortCycleTwoState)
 
 	// Startup Selection
-	SelectFirstCellOnStartup bool // true = automatically select cell (0,0) and set focus after data loaded
+	SelectFirstCellOnStartup bool             // true = automatically select cell (0,0) and set focus after data loaded (same as InitialSelectionFirstRow with FocusOnLoad)
+	InitialSelection         InitialSelection // Row selected, in the first visible column, when data is loaded (see SetData)
+	InitialSelectionKey      string           // Row key selected with InitialSelectionByKey (requires RowKey)
+	FocusOnLoad              bool             // true = request focus when data is loaded, whether or not a row is selected
+	PreserveScrollOnUpdate   bool             // true = SetData keeps scroll position and selection even when the row count changes (see SetData)
+	SelectionFollowsFilter   bool             // true = a selected row hidden by a filter or collapse moves to the nearest shown row, false = the selection is cleared
+
+	// Row Identity and State
+	DataEqual       func(a, b []interface{}) bool // Reports whether SetDataIfChanged's new data matches the current data (nil = only the same slice matches); a is in the order originally set
+	RowKey          func(data interface{}) string // Stable key for a row; selection follows rows by key across SetData and sorting (nil = selection by index)
+	IsRowSelectable func(data interface{}) bool   // false = row is skipped by arrow/Tab navigation, ignored on click, not editable and rendered muted (nil = all rows selectable)
 
 	// Indentation Control
 	ShowIndentIcons bool    // true = show visual indent icons (├ └), false = hide them
@@ -162,60 +300,120 @@ type Config struct {
 	ShowIndentation bool    // true = apply indentation spacing, false = no indentation
 
 	// Filter Control
-	FilterColumns []string // Column IDs to search/filter (empty = no filtering)
+	FilterColumns    []string   // Column IDs to search/filter (empty = no filtering unless SearchAllColumns)
+	SearchAllColumns bool       // true = search every visible column, ignoring FilterColumns
+	FilterMode       FilterMode // How plain-text search splits into terms, e.g. FilterAnd: "alice active" needs both (default: FilterPhrase; regex search is never split)
+	HighlightMatches bool       // true = show the text the search matched in bold in searched columns (default renderer, FontSize 0; not with FilterFuzzy)
 
 	// Tree Hierarchy Control
+	TreeColumn       string                             // Column ID that shows indentation, icons and the expand toggle (same as ColumnConfig.TreeColumn)
 	MaxDepth         int                                // Maximum depth to display (0 or nil = show all levels)
-	ExpandedNodes    map[interface{}]bool               // Track which nodes are expanded (nil = all expanded)
+	ExpandedNodes    map[interface{}]bool               // Track which nodes are expanded (nil or absent = expanded)
 	GetNodeID        func(data interface{}) interface{} // Get unique ID for a node (for expand/collapse tracking)
 	GetNodeDepth     func(data interface{}) int         // Get depth of a node
 	GetNodeParentID  func(data interface{}) interface{} // Get parent ID of a node (nil = root)
 	IsNodeExpandable func(data interface{}) bool        // Check if node has children
 
+	// Grouping
+	GroupByColumn      string                                              // Column ID to group rows by (empty = no grouping)
+	GroupAggregateFunc func(groupValue string, items []interface{}) string // Summary text for group headers (nil = count only)
+
+	// Detail Rows
+	DetailRenderer     func(data interface{}) fyne.CanvasObject // Detail content shown across a row opened with ToggleRowDetail (nil = no details; set before NewTable)
+	MultipleDetailRows bool                                     // true = several rows may show details at once, false = opening one closes the other
+
+	// Full-Width Rows
+	FullWidthRowRenderer    func(rowIndex int, data interface{}) fyne.CanvasObject // Widget replacing all of a row's cells, e.g. a section separator; nil = a normal row (called often: return nil quickly)
+	FullWidthRowsSelectable bool                                                   // true = full-width rows can be selected and navigated to, false = they are skipped like disabled rows
+
 	// Visual Styling
 	RootNodeBackgroundColor color.Color // Background color for root nodes (depth 0), nil = no background
 	FontFamily              string      // Font family name (empty = default)
-	FontSize                float32     // Font size in points (0 = default)
+	FontSize                float32     // Font size in points (0 = from Density, default: theme size)
+	BoolAsIcon              bool        // true = bool columns with BoolDisplayDefault show ✓/✗ instead of true/false
 
 	// Logging
-	Logger Logger // Logger interface for structured logging (nil = use NoopLogger)
+	Logger   Logger   // Logger interface for structured logging (nil = use NoopLogger)
+	LogLevel LogLevel // Minimum level passed to Logger (default: LogLevelDebug = everything)
 
 	// Callbacks
-	OnRowSelected func(rowIndex int, data interface{})
-	OnRowAction   func(action string, rowIndex int, data interface{})
-	OnCellEdited  func(rowIndex int, colID string, newValue string, data interface{})
+	OnRowSelected      func(rowIndex int, data interface{})
+	OnSelectionChanged func(selectedRows []int, data []interface{}) // Called once per change of the selected rows (click, Shift-click, Ctrl+A, SetSelectedRows, ...) with the sorted data indices and their items
+	OnRowAction        func(action string, rowIndex int, data interface{})
+	OnCellEdited       func(rowIndex int, colID string, newValue string, data interface{})
+	OnHeaderClick      func(columnID string, modifiers fyne.KeyModifier) (handled bool) // Called before the default sort toggle; return true to suppress sorting
+	OnSortChanged      func(columnID string, ascending bool)                            // Called after a header click, Sort, SetSortKeys or ClearSort applies a sort; columnID is the primary sort column ("" when the sort is cleared)
+	OnFocusGained      func()                                                           // Called when the table gains keyboard focus
+	OnFocusLost        func()                                                           // Called when the table loses keyboard focus (e.g. to route focus elsewhere)
+
+	// Accessibility
+	OnAccessibilityAnnouncement func(text string) // Called with a description of the selection when it changes (see AccessibleDescription)
 
 	// Persistence (optional)
-	SaveColumnWidths func(widths map[string]float32)
+	SaveColumnWidths func(widths map[string]float32) // Called with all widths after any column resize (drag or auto-resize)
 	LoadColumnWidths func() map[string]float32
+	OnColumnResized  func(columnID string, newWidth float32) // Called for each column resized by dragging or auto-resize
+
+	// Column management
+	OnColumnVisibilityChanged func(columnID string, visible bool) // Called after SetColumnVisibility shows or hides a column
+	OnColumnOrderChanged      func(order []string)                // Called with all column IDs after SetColumnOrder
 }
 
 // NewConfig creates a default table configuration
 func NewConfig(id string) *Config {
 	return &Config{
-		ID:                      id,
-		Columns:                 []ColumnConfig{},
-		RowHeight:               35.0,
-		HeaderHeight:            30.0,
-		AllowMultiSelect:        false,
-		ShowSearch:              false,
-		SearchPlaceholder:       "Search...",
-		FilterTitle:             "Search/Filter",
-		ShowToolbar:             false,
-		ShowBranch:              true,
-		RowSelectOnlyMode:       true,
-		EnableDoubleClickResize: true,
-		ShowHeaders:             true,
-		ShowIndentIcons:         true,
-		IndentPerLevel:          20.0,
-		ShowIndentation:         true,
-		MaxDepth:                0,                          // Show all levels by default
-		ExpandedNodes:           make(map[interface{}]bool), // All nodes collapsed initially
-		RootNodeBackgroundColor: nil,                        // No background by default
-		FontFamily:              "",                         // System default
-		FontSize:                0,                          // System default (usually 12-14pt)
-		Logger:                  NoopLogger{},               // Default noop logger
+		ID:                          id,
+		Columns:                     []ColumnConfig{},
+		Density:                     DensityComfortable,
+		AllowMultiSelect:            false,
+		ShowSearch:                  false,
+		SearchPlaceholder:           "Search...",
+		FilterTitle:                 "Search/Filter",
+		ShowToolbar:                 false,
+		ShowBranch:                  true,
+		RowSelectOnlyMode:           true,
+		EnableDoubleClickResize:     true,
+		ActivateOnSingleClick:       true,
+		PlainClickReplacesSelection: true,
+		TypeToEdit:                  true,
+		ShowHeaders:                 true,
+		SortAscIndicator:            "▲",
+		SortDescIndicator:           "▼",
+		ShowIndentIcons:             true,
+		IndentPerLevel:              20.0,
+		ShowIndentation:             true,
+		MaxDepth:                    0,                          // Show all levels by default
+		ExpandedNodes:               make(map[interface{}]bool), // All nodes expanded until toggled
+		RootNodeBackgroundColor:     nil,                        // No background by default
+		FontFamily:                  "",                         // System default
+		FontSize:                    0,                          // From Density (Comfortable: system default, usually 12-14pt)
+		Logger:                      NoopLogger{},               // Default noop logger
+	}
+}
+
+// rowHeight returns RowHeight, or the Density preset's row height if it is 0
+func (c *Config) rowHeight() float32 {
+	if c.RowHeight > 0 {
+		return c.RowHeight
+	}
+	return densityPresets[c.Density].rowHeight
+}
+
+// headerHeight returns HeaderHeight, or the Density preset's header height if it is 0
+func (c *Config) headerHeight() float32 {
+	if c.HeaderHeight > 0 {
+		return c.HeaderHeight
+	}
+	return densityPresets[c.Density].headerHeight
+}
+
+// fontSize returns FontSize, or the Density preset's font size if it is 0
+// (0 = theme text size)
+func (c *Config) fontSize() float32 {
+	if c.FontSize > 0 {
+		return c.FontSize
 	}
+	return densityPresets[c.Density].fontSize
 }
 
 // Validate checks if the configuration is valid.
@@ -230,9 +428,24 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// Clone returns a copy of the configuration that can be modified without affecting
+// the original. Columns, FilterColumns, ExpandedNodes and the tree icon theme are
+// copied; callbacks, the logger and colors are shared.
+func (c *Config) Clone() *Config {
+	clone := *c
+	clone.Columns = slices.Clone(c.Columns)
+	clone.FilterColumns = slices.Clone(c.FilterColumns)
+	clone.TreeIconTheme.Icons = slices.Clone(c.TreeIconTheme.Icons)
+	clone.ExpandedNodes = maps.Clone(c.ExpandedNodes)
+	return &clone
+}
+
 // NewStringComparator creates a comparator that extracts a string field and compares lexicographically
 func NewStringComparator(fieldName string) SortComparator {
 	return func(a, b interface{}) int {
+		if result, decided := compareMissing(a, b, fieldName); decided {
+			return result
+		}
 		valA := extractFieldString(a, fieldName)
 		valB := extractFieldString(b, fieldName)
 		if valA < valB {
@@ -247,6 +460,9 @@ func NewStringComparator(fieldName string) SortComparator {
 // NewNumericComparator creates a comparator that extracts a numeric field and compares numerically
 func NewNumericComparator(fieldName string) SortComparator {
 	return func(a, b interface{}) int {
+		if result, decided := compareMissing(a, b, fieldName); decided {
+			return result
+		}
 		valA := extractFieldNumeric(a, fieldName)
 		valB := extractFieldNumeric(b, fieldName)
 		if valA < valB {
@@ -258,60 +474,441 @@ func NewNumericComparator(fieldName string) SortComparator {
 	}
 }
 
+// NewCaseInsensitiveStringComparator creates a comparator that extracts a string field
+// and compares lexicographically ignoring case, so "alice" and "Alice" are equal
+func NewCaseInsensitiveStringComparator(fieldName string) SortComparator {
+	return func(a, b interface{}) int {
+		if result, decided := compareMissing(a, b, fieldName); decided {
+			return result
+		}
+		valA := strings.ToLower(extractFieldString(a, fieldName))
+		valB := strings.ToLower(extractFieldString(b, fieldName))
+		return strings.Compare(valA, valB)
+	}
+}
+
+// NewBoolComparator creates a comparator that extracts a bool field and sorts false
+// before true. Strings are parsed with strconv.ParseBool; other values count as false.
+func NewBoolComparator(fieldName string) SortComparator {
+	return func(a, b interface{}) int {
+		if result, decided := compareMissing(a, b, fieldName); decided {
+			return result
+		}
+		valA := extractFieldBool(a, fieldName)
+		valB := extractFieldBool(b, fieldName)
+		switch {
+		case valA == valB:
+			return 0
+		case !valA:
+			return -1
+		default:
+			return 1
+		}
+	}
+}
+
+// NewDateComparator creates a comparator that extracts a date field and compares
+// chronologically. time.Time values (and *time.Time, or types convertible to time.Time)
+// are compared directly; strings are parsed with layout (empty = time.RFC3339), so
+// "2024-1-10" sorts after "2024-01-02" with layout "2006-1-2". Values that are zero,
+// nil or don't parse sort after all valid dates in both directions.
+func NewDateComparator(fieldName string, layout string) SortComparator {
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return func(a, b interface{}) int {
+		if result, decided := compareMissing(a, b, fieldName); decided {
+			return result
+		}
+		timeA, okA := extractFieldTime(a, fieldName, layout)
+		timeB, okB := extractFieldTime(b, fieldName, layout)
+		switch {
+		case !okA && !okB:
+			return 0
+		case !okA:
+			return SortLast
+		case !okB:
+			return SortFirst
+		}
+		return timeA.Compare(timeB)
+	}
+}
+
+// NilsLast wraps a comparator so rows whose fieldName value is empty (missing, nil or
+// the zero value, e.g. "" or 0) sort after all other rows in both directions. inner
+// orders the non-empty rows (nil = NewStringComparator(fieldName)).
+func NilsLast(fieldName string, inner SortComparator) SortComparator {
+	return emptyPlacementComparator(fieldName, inner, SortLast)
+}
+
+// NilsFirst is like NilsLast but sorts empty values before all other rows
+func NilsFirst(fieldName string, inner SortComparator) SortComparator {
+	return emptyPlacementComparator(fieldName, inner, SortFirst)
+}
+
+// emptyPlacementComparator places rows with an empty field at placement (SortFirst or
+// SortLast) and compares the rest with inner
+func emptyPlacementComparator(fieldName string, inner SortComparator, placement int) SortComparator {
+	if inner == nil {
+		inner = NewStringComparator(fieldName)
+	}
+	opposite := SortFirst
+	if placement == SortFirst {
+		opposite = SortLast
+	}
+	return func(a, b interface{}) int {
+		emptyA, emptyB := isEmptyField(a, fieldName), isEmptyField(b, fieldName)
+		switch {
+		case emptyA && emptyB:
+			return 0
+		case emptyA:
+			return placement
+		case emptyB:
+			return opposite
+		}
+		return inner(a, b)
+	}
+}
+
+// isEmptyField reports whether data's fieldName value is missing, nil or a zero value
+func isEmptyField(data interface{}, fieldName string) bool {
+	value, ok := resolveFieldPath(data, fieldName)
+	if !ok {
+		if data == nil || isFieldPath(fieldName) || isRecordValue(data) {
+			return true
+		}
+		value = data // Plain value rows
+	}
+	if value == nil {
+		return true
+	}
+	return reflect.ValueOf(value).IsZero()
+}
+
+// CombineComparators chains comparators into a multi-key comparator: each is applied in
+// order until one returns non-zero, so later comparators only break ties of earlier ones.
+// Rows equal under every comparator compare as 0. nil comparators are skipped.
+//
+//	Comparator: table.CombineComparators(
+//		table.NewNumericComparator("Priority"),
+//		table.NewStringComparator("Name"),
+//	)
+func CombineComparators(cmps ...SortComparator) SortComparator {
+	cmps = slices.DeleteFunc(slices.Clone(cmps), func(cmp SortComparator) bool { return cmp == nil })
+	return func(a, b interface{}) int {
+		for _, cmp := range cmps {
+			if result := cmp(a, b); result != 0 {
+				return result // Includes SortFirst/SortLast, which keep their meaning
+			}
+		}
+		return 0
+	}
+}
+
+// NewNumberFormatter creates a column formatter that displays numbers with a fixed number
+// of decimals, optionally grouping thousands with commas (e.g. 1234.5 -> "1,234.50").
+// Non-numeric values are displayed with default formatting.
+func NewNumberFormatter(decimals int, thousandsSep bool) func(value interface{}) string {
+	return func(value interface{}) string {
+		v := reflect.ValueOf(value)
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+		default:
+			if value == nil {
+				return ""
+			}
+			return fmt.Sprintf("%v", value)
+		}
+
+		text := strconv.FormatFloat(toFloat64(value), 'f', decimals, 64)
+		if thousandsSep {
+			text = groupThousands(text)
+		}
+		return text
+	}
+}
+
+// groupThousands inserts commas between groups of three digits in the integer part of a formatted number
+func groupThousands(number string) string {
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	intPart, fracPart := number, ""
+	if dot := strings.IndexByte(number, '.'); dot >= 0 {
+		intPart, fracPart = number[:dot], number[dot:]
+	}
+
+	var b strings.Builder
+	for i, digit := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	return sign + b.String() + fracPart
+}
+
+// NewDateFormatter creates a column formatter that displays time.Time values using the
+// given layout (e.g. "2006-01-02"). Zero times display as blank; other values use default formatting.
+func NewDateFormatter(layout string) func(value interface{}) string {
+	return func(value interface{}) string {
+		switch t := value.(type) {
+		case time.Time:
+			if t.IsZero() {
+				return ""
+			}
+			return t.Format(layout)
+		case *time.Time:
+			if t == nil || t.IsZero() {
+				return ""
+			}
+			return t.Format(layout)
+		case nil:
+			return ""
+		default:
+			return fmt.Sprintf("%v", value)
+		}
+	}
+}
+
 // extractFieldString extracts a string value from a struct field by name (case-insensitive)
 func extractFieldString(data interface{}, fieldName string) string {
 	if data == nil {
 		return ""
 	}
 
+	// Field name or dotted path, on struct or map rows
+	if value, ok := resolveFieldPath(data, fieldName); ok {
+		return fmt.Sprintf("%v", value)
+	}
+	if isFieldPath(fieldName) || isRecordValue(data) {
+		return "" // Missing field or key, or nil along the path
+	}
+
+	return fmt.Sprintf("%v", data)
+}
+
+// extractFieldNumeric extracts a numeric value from a struct field by name (case-insensitive)
+func extractFieldNumeric(data interface{}, fieldName string) float64 {
+	if data == nil {
+		return 0
+	}
+
+	// Field name or dotted path, on struct or map rows
+	if value, ok := resolveFieldPath(data, fieldName); ok {
+		return toFloat64(value)
+	}
+	if isFieldPath(fieldName) {
+		return 0 // Missing field or nil along the path
+	}
+
 	v := reflect.ValueOf(data)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
+	if v.Kind() != reflect.Struct && v.Kind() != reflect.Map {
+		return toFloat64(data)
+	}
+
+	return 0
+}
 
-	if v.Kind() != reflect.Struct {
-		return fmt.Sprintf("%v", data)
+// extractFieldBool extracts a bool value from a struct field by name (case-insensitive)
+func extractFieldBool(data interface{}, fieldName string) bool {
+	value, ok := resolveFieldPath(data, fieldName)
+	if !ok {
+		if data == nil || isFieldPath(fieldName) || isRecordValue(data) {
+			return false
+		}
+		value = data // Plain value rows
 	}
 
-	for i := 0; i < v.NumField(); i++ {
-		field := v.Type().Field(i)
-		if strings.EqualFold(field.Name, fieldName) {
-			fieldValue := v.Field(i)
-			if fieldValue.CanInterface() {
-				return fmt.Sprintf("%v", fieldValue.Interface())
-			}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool() // Includes named bool types
+	case reflect.String:
+		parsed, _ := strconv.ParseBool(strings.TrimSpace(v.String()))
+		return parsed
+	default:
+		return false
+	}
+}
+
+// timeType is the reflect.Type of time.Time
+var timeType = reflect.TypeOf(time.Time{})
+
+// extractFieldTime extracts a date from a struct field by name (case-insensitive):
+// a time value, or a string parsed with layout. ok is false for missing, nil, zero and
+// unparseable values.
+func extractFieldTime(data interface{}, fieldName string, layout string) (t time.Time, ok bool) {
+	value, found := resolveFieldPath(data, fieldName)
+	if !found {
+		if data == nil || isFieldPath(fieldName) || isRecordValue(data) {
+			return time.Time{}, false
 		}
+		value = data // Plain value rows
 	}
 
-	return fmt.Sprintf("%v", data)
+	switch v := value.(type) {
+	case time.Time:
+		t = v
+	case *time.Time:
+		if v == nil {
+			return time.Time{}, false
+		}
+		t = *v
+	case string:
+		parsed, err := time.Parse(layout, strings.TrimSpace(v))
+		if err != nil {
+			return time.Time{}, false
+		}
+		t = parsed
+	default:
+		// Named types based on time.Time
+		rv := reflect.ValueOf(value)
+		if !rv.IsValid() || !rv.Type().ConvertibleTo(timeType) {
+			return time.Time{}, false
+		}
+		t = rv.Convert(timeType).Interface().(time.Time)
+	}
+	return t, !t.IsZero()
 }
 
-// extractFieldNumeric extracts a numeric value from a struct field by name (case-insensitive)
-func extractFieldNumeric(data interface{}, fieldName string) float64 {
-	if data == nil {
-		return 0
+// resolveFieldPath resolves a field name or dotted path (e.g. "Customer.Email") against
+// data, walking nested structs, maps with string keys, pointers and interfaces. Each
+// segment matches a struct field or map key exactly first, then case-insensitively.
+// ok is false if a segment is missing or an intermediate value is nil.
+func resolveFieldPath(data interface{}, path string) (value interface{}, ok bool) {
+	value, ok, _ = walkFieldPath(data, path)
+	return value, ok
+}
+
+// walkFieldPath resolves path like resolveFieldPath. missing is true when a non-nil
+// row or nested value has no such field or key (as opposed to a nil value along the path).
+func walkFieldPath(data interface{}, path string) (value interface{}, ok, missing bool) {
+	value = data
+	for _, name := range strings.Split(path, ".") {
+		v := reflect.ValueOf(value)
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return nil, false, false
+			}
+			v = v.Elem()
+		}
+
+		switch v.Kind() {
+		case reflect.Map:
+			if v.IsNil() {
+				return nil, false, false
+			}
+			value, ok = mapFieldValue(v, name)
+		case reflect.Struct:
+			value, ok = structFieldValue(v, name)
+		case reflect.Invalid:
+			return nil, false, false
+		default:
+			ok = false
+		}
+		if !ok {
+			return nil, false, true
+		}
 	}
+	return value, true, false
+}
+
+// isFieldPath reports whether a column ID is a dotted path into nested fields
+func isFieldPath(fieldName string) bool {
+	return strings.Contains(fieldName, ".")
+}
 
+// isRecordValue reports whether data is a struct or map (or pointer to one), i.e. a row
+// with fields. Columns missing from such a row display as blank rather than the whole row,
+// so datasets may mix row types that share only some fields.
+func isRecordValue(data interface{}) bool {
 	v := reflect.ValueOf(data)
 	if v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
+	return v.Kind() == reflect.Map || v.Kind() == reflect.Struct
+}
 
-	if v.Kind() != reflect.Struct {
-		return toFloat64(data)
+// lacksField reports whether data is a struct or map row whose type has no field (or
+// key) for fieldName, e.g. a column belonging to another row type in a mixed dataset.
+// Nil values along a path are not missing: they display and sort as blank.
+func lacksField(data interface{}, fieldName string) bool {
+	if data == nil || !isRecordValue(data) {
+		return false
+	}
+	_, _, missing := walkFieldPath(data, fieldName)
+	return missing
+}
+
+// compareMissing orders rows lacking the field after rows that have it. decided is
+// false if both or neither have the field.
+func compareMissing(a, b interface{}, fieldName string) (result int, decided bool) {
+	lacksA, lacksB := lacksField(a, fieldName), lacksField(b, fieldName)
+	switch {
+	case !lacksA && lacksB:
+		return -1, true
+	case lacksA && !lacksB:
+		return 1, true
 	}
+	return 0, false
+}
 
+// structFieldValue returns the exported field of struct v matching fieldName: a field
+// tagged `table:"fieldName"` first, then an exact name match, then a case-insensitive
+// one (e.g. "name" -> Name, "id" -> ID)
+func structFieldValue(v reflect.Value, fieldName string) (value interface{}, ok bool) {
+	t := v.Type()
 	for i := 0; i < v.NumField(); i++ {
-		field := v.Type().Field(i)
-		if strings.EqualFold(field.Name, fieldName) {
-			fieldValue := v.Field(i)
-			if fieldValue.CanInterface() {
-				return toFloat64(fieldValue.Interface())
-			}
+		if tag, found := t.Field(i).Tag.Lookup("table"); found && tagName(tag) == fieldName && v.Field(i).CanInterface() {
+			return v.Field(i).Interface(), true
 		}
 	}
 
-	return 0
+	if field := v.FieldByName(fieldName); field.IsValid() && field.CanInterface() {
+		return field.Interface(), true
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		if strings.EqualFold(t.Field(i).Name, fieldName) && v.Field(i).CanInterface() {
+			return v.Field(i).Interface(), true
+		}
+	}
+	return nil, false
+}
+
+// tagName returns the column ID of a `table` struct tag, ignoring options after a
+// comma as encoding/json does (`table:"name,omitempty"` -> "name")
+func tagName(tag string) string {
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+// mapFieldValue looks up fieldName in a map with string keys (e.g. map[string]interface{}
+// or map[string]string), trying an exact key match first, then a case-insensitive one.
+// ok is false if v is not such a map or has no matching key.
+func mapFieldValue(v reflect.Value, fieldName string) (value interface{}, ok bool) {
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String || v.IsNil() {
+		return nil, false
+	}
+
+	if elem := v.MapIndex(reflect.ValueOf(fieldName).Convert(v.Type().Key())); elem.IsValid() {
+		return elem.Interface(), true
+	}
+
+	iter := v.MapRange()
+	for iter.Next() {
+		if strings.EqualFold(iter.Key().String(), fieldName) {
+			return iter.Value().Interface(), true
+		}
+	}
+	return nil, false
 }
 
 // toFloat64 converts various numeric types to float64
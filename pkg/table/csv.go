@@ -0,0 +1,53 @@
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// LoadCSV reads CSV rows from r and sets them as the table data. Each row becomes a
+// map[string]string keyed by column ID, so each column displays the value stored
+// under its ID.
+//
+// With hasHeader, the first record names the columns and its values should match the
+// column IDs. Without a header, fields are assigned to Config.Columns in order.
+// Malformed CSV returns an error and leaves the current data unchanged.
+func (st *Table) LoadCSV(r io.Reader, hasHeader bool) error {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return &TableError{Op: "load CSV", Err: err}
+	}
+
+	var keys []string
+	if hasHeader {
+		if len(records) == 0 {
+			return &TableError{Op: "load CSV", Err: fmt.Errorf("missing header row")}
+		}
+		keys = records[0]
+		records = records[1:]
+	} else {
+		for _, col := range st.config.Columns {
+			keys = append(keys, col.ID)
+		}
+	}
+
+	data := make([]interface{}, 0, len(records))
+	for i, record := range records {
+		if len(record) > len(keys) {
+			line := i + 1
+			if hasHeader {
+				line++
+			}
+			return &TableError{Op: "load CSV", Err: fmt.Errorf("line %d: %d fields but only %d columns", line, len(record), len(keys))}
+		}
+		row := make(map[string]string, len(record))
+		for j, value := range record {
+			row[keys[j]] = value
+		}
+		data = append(data, row)
+	}
+
+	st.SetData(data)
+	return nil
+}
@@ -0,0 +1,275 @@
+package table
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Detail rows
+//
+// With Config.DetailRenderer set, ToggleRowDetail opens an inline detail area under a
+// row: the row grows by the detail's height and the detail is drawn below the row's
+// content, across all of its cells. Fyne tables can't span cells, so the details are
+// drawn on an overlay above the table widget, placed with CellBounds and re-laid out
+// as the table refreshes and scrolls. Details are keyed by data index and close when
+// the data is replaced or re-sorted.
+
+// ToggleRowDetail opens or closes the detail area of a data row. Opening a row closes
+// any other open detail unless Config.MultipleDetailRows is set. Does nothing if
+// Config.DetailRenderer is nil or rowIndex is out of range.
+func (st *Table) ToggleRowDetail(rowIndex int) {
+	if st.config.DetailRenderer == nil {
+		return
+	}
+
+	st.mu.Lock()
+	if rowIndex < 0 || rowIndex >= len(st.data) {
+		st.mu.Unlock()
+		return
+	}
+	open := !st.state.detailRows[rowIndex]
+	var data interface{}
+	if open {
+		if !st.config.MultipleDetailRows {
+			st.closeDetails()
+		}
+		if st.state.detailRows == nil {
+			st.state.detailRows = make(map[int]bool)
+		}
+		st.state.detailRows[rowIndex] = true
+		data = st.data[rowIndex]
+	} else {
+		delete(st.state.detailRows, rowIndex)
+		delete(st.detailObjects, rowIndex)
+	}
+	st.mu.Unlock()
+
+	// Build the detail content outside the lock (user callback)
+	if open {
+		detail := st.config.DetailRenderer(data)
+		st.mu.Lock()
+		if st.detailObjects == nil {
+			st.detailObjects = make(map[int]fyne.CanvasObject)
+		}
+		st.detailObjects[rowIndex] = detail
+		st.mu.Unlock()
+	}
+
+	st.refreshTable() // Applies the new row heights
+}
+
+// IsRowDetailOpen returns true if the data row's detail area is open
+func (st *Table) IsRowDetailOpen(rowIndex int) bool {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.state.detailRows[rowIndex]
+}
+
+// closeDetails closes all detail rows. Caller must hold st.mu.
+func (st *Table) closeDetails() {
+	st.state.detailRows = nil
+	st.detailObjects = nil
+}
+
+// hasOpenDetail returns true if the row's detail is open and built, so its cells keep
+// their content at the top of the enlarged row. Caller must hold st.mu.
+func (st *Table) hasOpenDetail(dataIndex int) bool {
+	return st.state.detailRows[dataIndex] && st.detailObjects[dataIndex] != nil
+}
+
+// applyDetailRowHeights sizes table rows for the open details: rows showing a detail
+// grow by its height, rows that no longer do return to the normal height. Display rows
+// move as rows are filtered or collapsed, so this runs before every table refresh.
+func (st *Table) applyDetailRowHeights() {
+	if st.table == nil || (len(st.state.detailRows) == 0 && len(st.detailHeightRows) == 0) {
+		return
+	}
+
+	st.mu.RLock()
+	heights := make(map[int]float32) // Table row -> height
+	baseHeight := st.baseRowHeight()
+	for displayRow, dataIndex := range st.state.visibleRows {
+		if detail := st.detailObjects[dataIndex]; detail != nil && st.state.detailRows[dataIndex] {
+			heights[displayRow+1] = baseHeight + theme.Padding() + detail.MinSize().Height // +1 for header row
+		}
+	}
+	st.mu.RUnlock()
+
+	for _, row := range st.detailHeightRows {
+		if _, stillOpen := heights[row]; !stillOpen {
+			st.table.SetRowHeight(row, baseHeight)
+		}
+	}
+	st.detailHeightRows = st.detailHeightRows[:0]
+	for row, height := range heights {
+		st.table.SetRowHeight(row, height)
+		st.detailHeightRows = append(st.detailHeightRows, row)
+	}
+}
+
+// baseRowHeight returns the normal height of a data row: the template cell height
+// once the table has rendered, otherwise a label's height
+func (st *Table) baseRowHeight() float32 {
+	if v := st.tableInternal("cellSize"); v.IsValid() {
+		if size, ok := v.Interface().(fyne.Size); ok && size.Height > 0 {
+			return size.Height
+		}
+	}
+	return widget.NewLabel("").MinSize().Height
+}
+
+// detailOverlay draws the open row details over the table widget's scrolling area,
+// each spanning its row's cells. Its renderer clips, so details scrolled under the
+// header rows or out of the table are cut off like cells.
+type detailOverlay struct {
+	widget.BaseWidget
+	table *Table
+}
+
+// createDetailOverlay creates the detail overlay if row details are enabled
+func (st *Table) createDetailOverlay() {
+	if st.config.DetailRenderer == nil {
+		return
+	}
+	st.detailOverlay = &detailOverlay{table: st}
+	st.detailOverlay.ExtendBaseWidget(st.detailOverlay)
+}
+
+// CreateRenderer implements fyne.Widget
+func (o *detailOverlay) CreateRenderer() fyne.WidgetRenderer {
+	return &detailOverlayRenderer{overlay: o}
+}
+
+// refreshDetailOverlay re-positions the detail overlay and its details after the
+// table's rows, columns or scroll position change
+func (st *Table) refreshDetailOverlay() {
+	if st.detailOverlay == nil || st.table == nil {
+		return
+	}
+	st.layoutDetailOverlay(st.table.Size())
+	st.detailOverlay.Refresh()
+}
+
+// layoutDetailOverlay places the overlay over the scrolling area of a table widget of
+// the given size, below the rows that stay at the top
+func (st *Table) layoutDetailOverlay(size fyne.Size) {
+	top := min(st.stickyRowsHeight(), size.Height)
+	st.detailOverlay.Move(fyne.NewPos(0, top))
+	st.detailOverlay.Resize(fyne.NewSize(size.Width, size.Height-top))
+}
+
+// stickyRowsHeight returns the height of the rows that stay at the top of the table
+// widget while it scrolls: Fyne's header row (if shown) and the column header row,
+// with the padding after them
+func (st *Table) stickyRowsHeight() float32 {
+	height := st.baseRowHeight()
+	if heights, ok := st.tableInternal("rowHeights").Interface().(map[int]float32); ok {
+		if h, found := heights[0]; found {
+			height = h
+		}
+	}
+	height += theme.Padding()
+	if st.table.ShowHeaderRow {
+		if v := st.tableInternal("headerSize"); v.IsValid() {
+			if headerSize, isSize := v.Interface().(fyne.Size); isSize {
+				height += headerSize.Height
+			}
+		}
+	}
+	return height
+}
+
+// placedDetail is an open row detail and where it is drawn, relative to the table widget
+type placedDetail struct {
+	object fyne.CanvasObject
+	pos    fyne.Position
+	size   fyne.Size
+}
+
+// placeDetails returns the open details of shown rows, each spanning its row from the
+// first visible column's left edge to the last one's right edge, at the bottom of the
+// enlarged row
+func (st *Table) placeDetails() []placedDetail {
+	st.mu.RLock()
+	if len(st.state.visibleColumns) == 0 {
+		st.mu.RUnlock()
+		return nil
+	}
+	firstCol := st.state.visibleColumns[0]
+	lastCol := st.state.visibleColumns[len(st.state.visibleColumns)-1]
+	details := make(map[int]fyne.CanvasObject, len(st.state.detailRows))
+	for dataIndex := range st.state.detailRows {
+		if st.hasOpenDetail(dataIndex) {
+			details[dataIndex] = st.detailObjects[dataIndex]
+		}
+	}
+	st.mu.RUnlock()
+
+	// CellBounds takes the lock itself
+	placed := make([]placedDetail, 0, len(details))
+	for dataIndex, object := range details {
+		firstPos, rowSize, ok := st.CellBounds(dataIndex, firstCol)
+		lastPos, lastSize, _ := st.CellBounds(dataIndex, lastCol)
+		if !ok {
+			continue // Filtered out or collapsed
+		}
+		height := min(object.MinSize().Height, rowSize.Height)
+		placed = append(placed, placedDetail{
+			object: object,
+			pos:    fyne.NewPos(firstPos.X, firstPos.Y+rowSize.Height-height),
+			size:   fyne.NewSize(lastPos.X+lastSize.Width-firstPos.X, height),
+		})
+	}
+	return placed
+}
+
+// detailOverlayLayout lays out the table widget and, over it, the detail overlay
+type detailOverlayLayout struct {
+	table *Table
+}
+
+func (l *detailOverlayLayout) Layout(objects []fyne.CanvasObject, size fyne.Size) {
+	objects[0].Move(fyne.NewPos(0, 0))
+	objects[0].Resize(size)
+	l.table.layoutDetailOverlay(size)
+}
+
+func (l *detailOverlayLayout) MinSize(objects []fyne.CanvasObject) fyne.Size {
+	return objects[0].MinSize()
+}
+
+// detailOverlayRenderer positions the open details within the overlay
+type detailOverlayRenderer struct {
+	overlay *detailOverlay
+	objects []fyne.CanvasObject
+}
+
+func (r *detailOverlayRenderer) Layout(fyne.Size) {
+	origin := r.overlay.Position() // The overlay's offset within the table widget
+	r.objects = r.objects[:0]
+	for _, d := range r.overlay.table.placeDetails() {
+		d.object.Move(d.pos.Subtract(origin))
+		d.object.Resize(d.size)
+		r.objects = append(r.objects, d.object)
+	}
+}
+
+func (r *detailOverlayRenderer) MinSize() fyne.Size {
+	return fyne.Size{}
+}
+
+func (r *detailOverlayRenderer) Refresh() {
+	r.Layout(r.overlay.Size())
+	canvas.Refresh(r.overlay)
+}
+
+func (r *detailOverlayRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *detailOverlayRenderer) Destroy() {}
+
+// IsClip tells Fyne to clip the details to the overlay's bounds
+func (r *detailOverlayRenderer) IsClip() {}
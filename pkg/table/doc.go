@@ -36,6 +36,10 @@
 //
 //	tableWidget := table.NewTable(config)
 //
+// Rows may be structs, whose fields are matched to column IDs, or maps with string
+// keys (such as map[string]interface{} or map[string]string), whose keys are matched
+// to column IDs case-insensitively.
+//
 // # Configuration
 //
 // The Config struct provides extensive customization options:
@@ -44,12 +48,12 @@
 //   - Data: Slice of data items (maps, structs, or any interface{})
 //   - Callbacks: OnRowSelected, OnCellEdited, OnKeyPressed for event handling
 //   - Styling: Header colors, selection colors, custom renderers
-//   - Logging: Optional Logger interface for debugging
+//   - Logging: Optional Logger interface for debugging (NewStdLogger, NewSlogLogger), filtered by Config.LogLevel
 //
 // # Keyboard Navigation
 //
 //   - Arrow Keys: Move cell selection
-//   - Tab/Shift+Tab: Move to next/previous cell
+//   - Tab/Shift+Tab: Move to next/previous cell, then out of the table
 //   - Enter: Start editing selected cell (if editable)
 //   - Escape: Cancel editing
 //   - Page Up/Down: Scroll by page
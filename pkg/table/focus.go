@@ -25,11 +25,27 @@ func NewDefaultFocusHandler() *DefaultFocusHandler {
 // HandleFocusGained is called when the table gains keyboard focus
 func (h *DefaultFocusHandler) HandleFocusGained(table *Table) {
 	table.state.hasFocus = true
+	if table.config.OnFocusGained != nil {
+		table.config.OnFocusGained()
+	}
 }
 
 // HandleFocusLost is called when the table loses keyboard focus
 func (h *DefaultFocusHandler) HandleFocusLost(table *Table) {
 	table.state.hasFocus = false
+	if table.config.OnFocusLost != nil {
+		table.config.OnFocusLost()
+	}
+}
+
+// focusNext moves keyboard focus to Config.FocusNext, if set and on the table's canvas
+func (st *Table) focusNext() {
+	if st.config.FocusNext == nil || st.table == nil {
+		return
+	}
+	if canvas := fyne.CurrentApp().Driver().CanvasForObject(st.table); canvas != nil {
+		canvas.Focus(st.config.FocusNext)
+	}
 }
 
 // RequestFocus requests keyboard focus for the table
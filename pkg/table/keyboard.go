@@ -1,8 +1,6 @@
 package table
 
 import (
-	"fmt"
-
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/widget"
@@ -26,8 +24,10 @@ func NewDefaultKeyHandler() *DefaultKeyHandler {
 
 // HandleKey processes regular key events
 func (h *DefaultKeyHandler) HandleKey(key *fyne.KeyEvent, table *Table) {
-	// Don't handle keys if we're currently editing (text entry mode)
+	// Don't handle keys if we're currently editing (text entry mode); check and select box
+	// edits leave the focus on the table
 	if table.state.editingRow >= 0 && table.state.editingCol >= 0 {
+		h.handleEditWidgetKey(key, table)
 		return
 	}
 
@@ -48,6 +48,8 @@ func (h *DefaultKeyHandler) HandleKey(key *fyne.KeyEvent, table *Table) {
 		h.handlePageNavigation("home", table)
 	case fyne.KeyEnd:
 		h.handlePageNavigation("end", table)
+	case fyne.KeyTab:
+		h.handleTabKey(!table.isShiftPressed(), table)
 	case fyne.KeySpace:
 		// Space key - unified "activate cell" behavior
 		if table.state.selectedRow >= 0 && table.state.selectedCol >= 0 {
@@ -55,31 +57,31 @@ func (h *DefaultKeyHandler) HandleKey(key *fyne.KeyEvent, table *Table) {
 			if table.state.selectedCol < len(table.config.Columns) {
 				col := table.config.Columns[table.state.selectedCol]
 
-				table.logger().Info(fmt.Sprintf("[DEBUG] SPACE key: row=%d col=%d colID=%s Editable=%v ReadOnly=%v",
-					table.state.selectedRow, table.state.selectedCol, col.ID, col.Editable, col.ReadOnly))
+				table.logger().Debug("[KEY] Space", "row", table.state.selectedRow, "col", table.state.selectedCol, "column", col.ID,
+					"editable", col.Editable, "readOnly", col.ReadOnly)
 
-				// Priority 1: Popup menu
-				if col.PopupOptions != nil && !table.isColumnReadOnly(table.state.selectedCol) {
-					table.logger().Info("[DEBUG] Showing popup menu")
+				// Priority 1: Popup menu (EditSelect columns edit with a select box instead)
+				if col.PopupOptions != nil && col.EditType != EditSelect && !table.isColumnReadOnly(table.state.selectedCol) {
+					table.logger().Debug("[KEY] Space: showing popup menu")
 					h.handleSpaceKeyPopup(table)
 					return
 				}
 
 				// Priority 2: Checkbox toggle
 				if col.ShowCheckbox && !table.isColumnReadOnly(table.state.selectedCol) {
-					table.logger().Info("[DEBUG] Toggling checkbox")
+					table.logger().Debug("[KEY] Space: toggling checkbox")
 					h.handleSpaceKeyCheckbox(table)
 					return
 				}
 
 				// Priority 3: Inline text editing
 				if col.Editable && !table.isColumnReadOnly(table.state.selectedCol) {
-					table.logger().Info("[DEBUG] Starting inline edit")
+					table.logger().Debug("[KEY] Space: starting inline edit")
 					table.startEdit(table.state.selectedRow, table.state.selectedCol)
 					return
 				}
 
-				table.logger().Info("[DEBUG] No action for SPACE key")
+				table.logger().Debug("[KEY] Space: no action")
 			}
 		}
 		// Note: Don't forward to table.table.TypedKey as it would cause infinite recursion
@@ -88,8 +90,8 @@ func (h *DefaultKeyHandler) HandleKey(key *fyne.KeyEvent, table *Table) {
 		if table.state.selectedRow >= 0 && table.state.selectedCol >= 0 && table.state.selectedCol < len(table.config.Columns) {
 			col := table.config.Columns[table.state.selectedCol]
 
-			// Priority 1: Popup menu
-			if col.PopupOptions != nil && !table.isColumnReadOnly(table.state.selectedCol) {
+			// Priority 1: Popup menu (EditSelect columns edit with a select box instead)
+			if col.PopupOptions != nil && col.EditType != EditSelect && !table.isColumnReadOnly(table.state.selectedCol) {
 				h.handleSpaceKeyPopup(table)
 				return
 			}
@@ -109,6 +111,27 @@ func (h *DefaultKeyHandler) HandleKey(key *fyne.KeyEvent, table *Table) {
 	}
 }
 
+// handleEditWidgetKey handles keys during an EditBool or EditSelect edit: Escape
+// cancels, and Space or Enter toggles the check (saving it) or opens the select box
+func (h *DefaultKeyHandler) handleEditWidgetKey(key *fyne.KeyEvent, table *Table) {
+	switch editor := table.editingWidget.(type) {
+	case *widget.Check:
+		switch key.Name {
+		case fyne.KeyEscape:
+			table.cancelEdit()
+		case fyne.KeySpace, fyne.KeyReturn, fyne.KeyEnter:
+			editor.SetChecked(!editor.Checked) // Saves through OnChanged
+		}
+	case *widget.Select:
+		switch key.Name {
+		case fyne.KeyEscape:
+			table.cancelEdit()
+		case fyne.KeySpace, fyne.KeyReturn, fyne.KeyEnter:
+			editor.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace}) // Shows the options
+		}
+	}
+}
+
 // HandleShortcut processes keyboard shortcuts
 func (h *DefaultKeyHandler) HandleShortcut(shortcut fyne.Shortcut, table *Table) {
 	// Don't handle shortcuts if we're currently editing
@@ -116,6 +139,14 @@ func (h *DefaultKeyHandler) HandleShortcut(shortcut fyne.Shortcut, table *Table)
 		return
 	}
 
+	// Ctrl+A (Cmd+A on Mac) selects all visible rows in multi-select mode
+	if _, ok := shortcut.(*fyne.ShortcutSelectAll); ok {
+		if table.config.AllowMultiSelect {
+			table.SelectAllVisible()
+		}
+		return
+	}
+
 	// Don't handle shortcuts if no row is selected
 	if table.state.selectedRow < 0 {
 		return
@@ -139,9 +170,9 @@ func (h *DefaultKeyHandler) handleArrowKey(direction string, table *Table) {
 	// Initialize selection to first row if nothing selected
 	if table.state.selectedRow < 0 {
 		if len(table.data) > 0 {
-			// Select first visible row
-			if len(table.state.visibleRows) > 0 {
-				table.state.selectedRow = table.state.visibleRows[0]
+			// Select first visible row (skipping group headers and disabled rows)
+			if rows := table.selectableRows(); len(rows) > 0 {
+				table.state.selectedRow = rows[0]
 			} else {
 				table.state.selectedRow = 0
 			}
@@ -155,10 +186,13 @@ func (h *DefaultKeyHandler) handleArrowKey(direction string, table *Table) {
 				// Set flag to prevent auto-activation during initial keyboard selection
 				table.state.isKeyboardNavigation = true
 
-				// First visible row is always displayRow 1 (row 0 is header)
-				displayRow := 1
+				// Display row of the selected row (row 0 is header)
+				displayRow := indexOf(table.state.visibleRows, table.state.selectedRow) + 1
+				if displayRow < 1 {
+					displayRow = 1
+				}
 				table.table.Select(widget.TableCellID{Row: displayRow, Col: 0})
-				table.table.Refresh()
+				table.refreshTable()
 
 				// Clear the keyboard navigation flag after selection is complete
 				table.state.isKeyboardNavigation = false
@@ -170,13 +204,15 @@ func (h *DefaultKeyHandler) handleArrowKey(direction string, table *Table) {
 	oldRow := table.state.selectedRow
 	oldCol := table.state.selectedCol
 
+	rows := table.selectableRows() // Up/Down jump over disabled rows
+
 	switch direction {
 	case "up":
 		// Move to previous row in visible rows
-		if len(table.state.visibleRows) > 0 {
+		if len(rows) > 0 {
 			// Find current position in visibleRows
 			currentIdx := -1
-			for idx, dataIdx := range table.state.visibleRows {
+			for idx, dataIdx := range rows {
 				if dataIdx == table.state.selectedRow {
 					currentIdx = idx
 					break
@@ -184,29 +220,29 @@ func (h *DefaultKeyHandler) handleArrowKey(direction string, table *Table) {
 			}
 			// Move to previous visible row
 			if currentIdx > 0 {
-				table.state.selectedRow = table.state.visibleRows[currentIdx-1]
-			} else if currentIdx < 0 && len(table.state.visibleRows) > 0 {
+				table.state.selectedRow = rows[currentIdx-1]
+			} else if currentIdx < 0 && len(rows) > 0 {
 				// Not found, default to first visible row
-				table.state.selectedRow = table.state.visibleRows[0]
+				table.state.selectedRow = rows[0]
 			}
 		}
 	case "down":
 		// Move to next row in visible rows
-		if len(table.state.visibleRows) > 0 {
+		if len(rows) > 0 {
 			// Find current position in visibleRows
 			currentIdx := -1
-			for idx, dataIdx := range table.state.visibleRows {
+			for idx, dataIdx := range rows {
 				if dataIdx == table.state.selectedRow {
 					currentIdx = idx
 					break
 				}
 			}
 			// Move to next visible row
-			if currentIdx >= 0 && currentIdx < len(table.state.visibleRows)-1 {
-				table.state.selectedRow = table.state.visibleRows[currentIdx+1]
-			} else if currentIdx < 0 && len(table.state.visibleRows) > 0 {
+			if currentIdx >= 0 && currentIdx < len(rows)-1 {
+				table.state.selectedRow = rows[currentIdx+1]
+			} else if currentIdx < 0 && len(rows) > 0 {
 				// Not found, default to first visible row
-				table.state.selectedRow = table.state.visibleRows[0]
+				table.state.selectedRow = rows[0]
 			}
 		}
 	case "left":
@@ -253,51 +289,74 @@ func (h *DefaultKeyHandler) handleArrowKey(direction string, table *Table) {
 
 	// Log if selection changed
 	if oldRow != table.state.selectedRow || oldCol != table.state.selectedCol {
+		h.scrollToSelection(table)
+	}
+}
 
-		// Set flag to prevent auto-activation during keyboard navigation
-		table.state.isKeyboardNavigation = true
-
-		// Programmatically select the cell in the underlying table to trigger auto-scroll
-		if table.table != nil {
-			// Map data row index to display row index
-			// selectedRow is a data index, we need to find its position in visibleRows
-			displayRow := -1
-			for displayIdx, dataIdx := range table.state.visibleRows {
-				if dataIdx == table.state.selectedRow {
-					displayRow = displayIdx + 1 // +1 for header
-					break
-				}
-			}
+// scrollToSelection mirrors the current selection into the underlying table so it
+// auto-scrolls to the selected cell, without triggering cell activation
+func (h *DefaultKeyHandler) scrollToSelection(table *Table) {
+	// Set flag to prevent auto-activation during keyboard navigation
+	table.state.isKeyboardNavigation = true
 
-			// Fallback if not found in visibleRows (shouldn't happen normally)
-			if displayRow < 0 {
-				displayRow = table.state.selectedRow + 1
+	// Programmatically select the cell in the underlying table to trigger auto-scroll
+	if table.table != nil {
+		// Map data row index to display row index
+		// selectedRow is a data index, we need to find its position in visibleRows
+		displayRow := -1
+		for displayIdx, dataIdx := range table.state.visibleRows {
+			if dataIdx == table.state.selectedRow {
+				displayRow = displayIdx + 1 // +1 for header
+				break
 			}
+		}
 
-			// Map actual column to display column
-			displayCol := -1
-			for idx, actualCol := range table.state.visibleColumns {
-				if actualCol == table.state.selectedCol {
-					displayCol = idx
-					break
-				}
-			}
+		// Fallback if not found in visibleRows (shouldn't happen normally)
+		if displayRow < 0 {
+			displayRow = table.state.selectedRow + 1
+		}
 
-			// If we have a valid display column, select it (or select first column in row-only mode)
-			if displayCol >= 0 {
-				table.table.Select(widget.TableCellID{Row: displayRow, Col: displayCol})
-			} else if table.config.RowSelectOnlyMode && len(table.state.visibleColumns) > 0 {
-				// In row-only mode, select first visible column for scrolling purposes
-				table.table.Select(widget.TableCellID{Row: displayRow, Col: 0})
+		// Map actual column to display column
+		displayCol := -1
+		for idx, actualCol := range table.state.visibleColumns {
+			if actualCol == table.state.selectedCol {
+				displayCol = idx
+				break
 			}
+		}
 
-			// Refresh to update highlighting - this will re-render all visible cells
-			table.table.Refresh()
+		// If we have a valid display column, select it (or select first column in row-only mode)
+		if displayCol >= 0 {
+			table.table.Select(widget.TableCellID{Row: displayRow, Col: displayCol})
+		} else if table.config.RowSelectOnlyMode && len(table.state.visibleColumns) > 0 {
+			// In row-only mode, select first visible column for scrolling purposes
+			table.table.Select(widget.TableCellID{Row: displayRow, Col: 0})
 		}
 
-		// Clear the keyboard navigation flag after selection is complete
-		table.state.isKeyboardNavigation = false
+		// Refresh to update highlighting - this will re-render all visible cells
+		table.refreshTable()
+	}
+
+	// Clear the keyboard navigation flag after selection is complete
+	table.state.isKeyboardNavigation = false
+}
+
+// handleTabKey moves the selection to the next (or previous) cell in reading order.
+// When there is no cell to move to, the key is left unconsumed so focus can leave
+// the table (see Table.AcceptsTab), or focus moves to Config.FocusNext when leaving forwards.
+func (h *DefaultKeyHandler) handleTabKey(forward bool, table *Table) {
+	row, col, ok := table.nextTabCell(forward)
+	if !ok || table.config.TabMovesFocusOut {
+		if forward {
+			table.focusNext()
+		}
+		return
 	}
+
+	table.state.selectedRow = row
+	table.state.selectedCol = col
+	table.state.selectedRows = make(map[int]bool) // Clear multi-select
+	h.scrollToSelection(table)
 }
 
 // handlePageNavigation handles page-based navigation (PgUp, PgDown, Home, End)
@@ -368,7 +427,7 @@ func (h *DefaultKeyHandler) handlePageNavigation(direction string, table *Table)
 			}
 
 			// Refresh to update highlighting
-			table.table.Refresh()
+			table.refreshTable()
 		}
 
 		// Clear the keyboard navigation flag after selection is complete
@@ -470,22 +529,9 @@ func (h *DefaultKeyHandler) handleSpaceKeyCheckbox(table *Table) {
 	// CRITICAL: Restore navigation state to the cell where checkbox was toggled
 	// The OnCellEdited callback may have triggered state changes
 	// But we want arrow keys to continue from where the checkbox toggle occurred
-	table.logger().Info(fmt.Sprintf("[CHECKBOX-KEY] Restoring navigation state from (%d,%d) to (%d,%d)",
-		table.state.selectedRow, table.state.selectedCol, rowIndex, colIndex))
-	table.state.selectedRow = rowIndex
-	table.state.selectedCol = colIndex
-
-	// Refresh the entire table to show updated state
-	// Note: We refresh the whole table instead of just the cell to avoid
-	// unintended side effects from RefreshItem (which can cause selection changes)
-	// We DON'T call Select() because that would trigger OnSelected callbacks
-	// and potentially change our navigation state. Our selectedRow/selectedCol
-	// remain unchanged, so arrow keys will work correctly from the current position.
-	if table.table != nil {
-		table.logger().Info(fmt.Sprintf("[REFRESH-KEY] Refreshing table after checkbox toggle, navigation state: row=%d col=%d", table.state.selectedRow, table.state.selectedCol))
-		table.table.Refresh()
-		table.logger().Info(fmt.Sprintf("[REFRESH-KEY] Table refreshed, navigation state preserved: row=%d col=%d", table.state.selectedRow, table.state.selectedCol))
-	}
+	table.logger().Debug("[CHECKBOX-KEY] Restoring navigation state", "fromRow", table.state.selectedRow, "fromCol", table.state.selectedCol,
+		"row", rowIndex, "col", colIndex)
+	table.moveSelectionTo(rowIndex, colIndex)
 
-	table.logger().Info(fmt.Sprintf("Checkbox toggled: %t -> %t for row %d, col %s", currentValue, newValue, rowIndex, col.ID))
+	table.logger().Info("Checkbox toggled", "from", currentValue, "to", newValue, "row", rowIndex, "column", col.ID)
 }
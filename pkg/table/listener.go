@@ -0,0 +1,127 @@
+package table
+
+import (
+	"slices"
+
+	"fyne.io/fyne/v2/data/binding"
+)
+
+// Change listeners
+//
+// Like Fyne's data bindings, a Table notifies binding.DataListeners when its view
+// changes: data, sort, filter, selection, or column visibility and order. Each
+// operation notifies once, however many of these it changes (e.g. a filter that also
+// clears the selection). Notifications during a batch update are held until EndUpdate.
+
+// AddListener registers l to be notified after the data, sort, filter, selection or
+// column visibility changes. Listeners are called on the goroutine that made the change,
+// without the table's lock held.
+//
+//	table.AddListener(binding.NewDataListener(func() {
+//		summary.SetText(fmt.Sprintf("%d of %d rows", table.GetVisibleRowCount(), table.GetTotalRowCount()))
+//	}))
+func (st *Table) AddListener(l binding.DataListener) {
+	st.listenersMu.Lock()
+	defer st.listenersMu.Unlock()
+	st.listeners = append(st.listeners, l)
+}
+
+// RemoveListener unregisters a listener added with AddListener
+func (st *Table) RemoveListener(l binding.DataListener) {
+	st.listenersMu.Lock()
+	defer st.listenersMu.Unlock()
+	st.listeners = slices.DeleteFunc(st.listeners, func(other binding.DataListener) bool {
+		return other == l
+	})
+}
+
+// notifyListeners reports a change to the listeners, or records it for later while
+// notifications are deferred (see deferNotify and BeginUpdate)
+func (st *Table) notifyListeners() {
+	st.listenersMu.Lock()
+	if st.notifyDepth > 0 || st.updateDepth > 0 {
+		st.notifyPending = true
+		st.listenersMu.Unlock()
+		return
+	}
+	listeners := slices.Clone(st.listeners)
+	st.listenersMu.Unlock()
+
+	st.selectionChanged()
+	for _, l := range listeners {
+		l.DataChanged()
+	}
+}
+
+// selectionChanged calls Config.OnSelectionChanged if the selected rows differ from
+// those it was last called with. Selection changes always notify the listeners, so
+// checking here reports each change once per operation, and re-selecting the same
+// rows (e.g. after a refresh) reports nothing.
+func (st *Table) selectionChanged() {
+	if st.config.OnSelectionChanged == nil {
+		return
+	}
+
+	st.mu.RLock()
+	var rows []int
+	var items []interface{}
+	for _, row := range st.state.GetSelectedRows() {
+		if row < len(st.data) {
+			rows = append(rows, row)
+			items = append(items, st.data[row])
+		}
+	}
+	st.mu.RUnlock()
+
+	st.listenersMu.Lock()
+	changed := !slices.Equal(rows, st.lastSelection)
+	st.lastSelection = rows
+	st.listenersMu.Unlock()
+
+	if changed {
+		st.config.OnSelectionChanged(slices.Clone(rows), items)
+	}
+}
+
+// deferNotify holds notifications until the returned function is called, so an
+// operation built from other notifying operations notifies once. Calls may be nested.
+func (st *Table) deferNotify() (done func()) {
+	st.listenersMu.Lock()
+	st.notifyDepth++
+	st.listenersMu.Unlock()
+
+	return func() {
+		st.listenersMu.Lock()
+		st.notifyDepth--
+		st.listenersMu.Unlock()
+		st.flushListeners()
+	}
+}
+
+// flushListeners sends a held notification once nothing defers it any more
+func (st *Table) flushListeners() {
+	st.listenersMu.Lock()
+	pending := st.notifyPending && st.notifyDepth == 0 && st.updateDepth == 0
+	if pending {
+		st.notifyPending = false
+	}
+	st.listenersMu.Unlock()
+
+	if pending {
+		st.notifyListeners()
+	}
+}
+
+// notifyInput runs fn, the handling of a key, shortcut or click, and notifies the
+// listeners once for the changes it made. Handlers move the selection on the state
+// directly, so selection changes are found by comparing before and after.
+func (st *Table) notifyInput(fn func()) {
+	done := st.deferNotify()
+	defer done()
+
+	row, col, rows := st.state.selectedRow, st.state.selectedCol, st.state.GetSelectedRows()
+	fn()
+	if row != st.state.selectedRow || col != st.state.selectedCol || !slices.Equal(rows, st.state.GetSelectedRows()) {
+		st.notifyListeners()
+	}
+}
@@ -1,5 +1,11 @@
 package table
 
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
 // Logger defines the interface for table widget logging.
 // By default, the table uses NoopLogger (silent). Users can inject
 // their own logger implementation for debugging.
@@ -20,6 +26,50 @@ type Logger interface {
 	Error(msg string, keyvals ...interface{})
 }
 
+// LogLevel is the minimum severity of messages passed to Config.Logger
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = iota // Log everything (default)
+	LogLevelInfo                  // Info, Warn and Error
+	LogLevelWarn                  // Warn and Error
+	LogLevelError                 // Error only
+)
+
+// levelFilterLogger passes messages at or above a minimum level to another logger
+type levelFilterLogger struct {
+	logger Logger
+	level  LogLevel
+}
+
+// Debug forwards the message if the level allows debug output
+func (l levelFilterLogger) Debug(msg string, keyvals ...interface{}) {
+	if l.level <= LogLevelDebug {
+		l.logger.Debug(msg, keyvals...)
+	}
+}
+
+// Info forwards the message if the level allows info output
+func (l levelFilterLogger) Info(msg string, keyvals ...interface{}) {
+	if l.level <= LogLevelInfo {
+		l.logger.Info(msg, keyvals...)
+	}
+}
+
+// Warn forwards the message if the level allows warnings
+func (l levelFilterLogger) Warn(msg string, keyvals ...interface{}) {
+	if l.level <= LogLevelWarn {
+		l.logger.Warn(msg, keyvals...)
+	}
+}
+
+// Error forwards the message if the level allows errors
+func (l levelFilterLogger) Error(msg string, keyvals ...interface{}) {
+	if l.level <= LogLevelError {
+		l.logger.Error(msg, keyvals...)
+	}
+}
+
 // NoopLogger is a logger that discards all log messages.
 // This is the default logger used when Config.Logger is nil.
 type NoopLogger struct{}
@@ -82,3 +132,76 @@ func (l *StdLogger) Warn(msg string, keyvals ...interface{}) {
 func (l *StdLogger) Error(msg string, keyvals ...interface{}) {
 	l.output("[ERROR] %s %v\n", msg, keyvals)
 }
+
+// SlogLogger adapts a standard library *slog.Logger to the Logger interface.
+// Debug/Info/Warn/Error map to the matching slog levels, and keyvals are passed
+// as slog attributes.
+//
+// Example:
+//
+//	config.Logger = table.NewSlogLogger(slog.Default())
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger creates a logger that writes to the provided slog logger
+// (slog.Default() if nil).
+func NewSlogLogger(logger *slog.Logger) Logger {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &SlogLogger{logger: logger}
+}
+
+// Debug logs at slog.LevelDebug
+func (l *SlogLogger) Debug(msg string, keyvals ...interface{}) {
+	l.log(slog.LevelDebug, msg, keyvals)
+}
+
+// Info logs at slog.LevelInfo
+func (l *SlogLogger) Info(msg string, keyvals ...interface{}) {
+	l.log(slog.LevelInfo, msg, keyvals)
+}
+
+// Warn logs at slog.LevelWarn
+func (l *SlogLogger) Warn(msg string, keyvals ...interface{}) {
+	l.log(slog.LevelWarn, msg, keyvals)
+}
+
+// Error logs at slog.LevelError
+func (l *SlogLogger) Error(msg string, keyvals ...interface{}) {
+	l.log(slog.LevelError, msg, keyvals)
+}
+
+// log emits a record with keyvals paired into attributes
+func (l *SlogLogger) log(level slog.Level, msg string, keyvals []interface{}) {
+	ctx := context.Background()
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	l.logger.LogAttrs(ctx, level, msg, keyvalAttrs(keyvals)...)
+}
+
+// keyvalAttrs pairs alternating keys and values into slog attributes. Non-string
+// keys are formatted with %v, and a trailing value without a key is reported
+// under slog's "!BADKEY" key.
+func keyvalAttrs(keyvals []interface{}) []slog.Attr {
+	attrs := make([]slog.Attr, 0, (len(keyvals)+1)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		if attr, ok := keyvals[i].(slog.Attr); ok {
+			attrs = append(attrs, attr)
+			i-- // An Attr is a complete pair
+			continue
+		}
+		if i+1 >= len(keyvals) {
+			attrs = append(attrs, slog.Any("!BADKEY", keyvals[i]))
+			break
+		}
+		key, ok := keyvals[i].(string)
+		if !ok {
+			key = fmt.Sprint(keyvals[i])
+		}
+		attrs = append(attrs, slog.Any(key, keyvals[i+1]))
+	}
+	return attrs
+}
@@ -1,7 +1,7 @@
 package table
 
 import (
-	"fmt"
+	"slices"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/widget"
@@ -43,8 +43,22 @@ func (h *DefaultMouseHandler) HandleDoubleTap(ev *fyne.PointEvent, table *Table)
 		colIndex := table.findColumnDividerAtPosition(ev.Position)
 		if colIndex >= 0 && colIndex < len(table.config.Columns) {
 			table.autoResizeColumn(colIndex)
+			return
 		}
 	}
+
+	// Ignore double-taps elsewhere in the header row
+	headerHeight := table.config.headerHeight()
+	if table.table == nil || (table.config.ShowHeaders && ev.Position.Y <= headerHeight) {
+		return
+	}
+
+	// Double-tap on a data cell: select the cell under the pointer (Fyne delivers
+	// DoubleTapped instead of Tapped), then activate it if single click did not
+	table.table.Tapped(ev)
+	if !table.config.ActivateOnSingleClick {
+		h.activateInteractiveCell(table, table.state.selectedRow, table.state.selectedCol)
+	}
 }
 
 // HandleCellClick processes cell selection events
@@ -62,10 +76,30 @@ func (h *DefaultMouseHandler) HandleCellClick(id widget.TableCellID, table *Tabl
 	}
 
 	dataIndex := table.state.visibleRows[displayRowIndex]
+
+	// Group header row - clicking anywhere on it toggles the group
+	if g, ok := groupIndexOf(dataIndex); ok {
+		if !table.state.isReselecting && !table.state.isKeyboardNavigation && g < len(table.state.groups) {
+			table.ToggleGroupCollapsed(table.state.groups[g].value)
+			if table.table != nil {
+				table.table.Unselect(id) // Allow the next click on this cell to toggle again
+			}
+		}
+		return
+	}
+
 	if dataIndex < 0 || dataIndex >= len(table.data) {
 		return
 	}
 
+	// Disabled rows ignore clicks and keep the current selection
+	if !table.isRowSelectable(dataIndex) {
+		if table.table != nil {
+			table.table.Unselect(id)
+		}
+		return
+	}
+
 	// Map display column index to actual column index
 	if id.Col >= 0 && id.Col < len(table.state.visibleColumns) {
 		table.state.selectedCol = table.state.visibleColumns[id.Col]
@@ -78,11 +112,22 @@ func (h *DefaultMouseHandler) HandleCellClick(id widget.TableCellID, table *Tabl
 
 	// Handle selection based on multi-select mode
 	if table.config.AllowMultiSelect {
-		// In multi-select mode: toggle row selection
-		if table.state.IsRowSelected(dataIndex) {
-			table.state.RemoveSelectedRow(dataIndex)
-		} else {
-			table.state.AddSelectedRow(dataIndex)
+		// In multi-select mode: Shift-click selects the rows from the anchor (the last row
+		// clicked without Shift) to this one, adding them with Ctrl/Cmd; Ctrl/Cmd-click
+		// toggles the row, plain click replaces the selection (or toggles too when
+		// PlainClickReplacesSelection is off)
+		mouseClick := !table.state.isKeyboardNavigation && !table.state.isReselecting
+		if !mouseClick || !table.isShiftPressed() || !h.selectRange(table, dataIndex, table.isToggleSelectPressed()) {
+			if !table.config.PlainClickReplacesSelection || table.isToggleSelectPressed() {
+				if table.state.IsRowSelected(dataIndex) {
+					table.state.RemoveSelectedRow(dataIndex)
+				} else {
+					table.state.AddSelectedRow(dataIndex)
+				}
+			} else {
+				table.state.SetSelectedRows([]int{dataIndex})
+			}
+			table.state.anchorRow = dataIndex
 		}
 
 		// Fire OnRowSelected for each selected row (skip during programmatic re-selection)
@@ -96,8 +141,8 @@ func (h *DefaultMouseHandler) HandleCellClick(id widget.TableCellID, table *Tabl
 		}
 	} else {
 		// Single-select mode: replace selection
-		table.logger().Info(fmt.Sprintf("[CLICK] HandleCellClick: id={Row:%d,Col:%d} displayRowIndex=%d dataIndex=%d isReselecting=%v",
-			id.Row, id.Col, displayRowIndex, dataIndex, table.state.isReselecting))
+		table.logger().Debug("[CLICK] HandleCellClick", "tableRow", id.Row, "tableCol", id.Col, "displayRow", displayRowIndex,
+			"row", dataIndex, "isReselecting", table.state.isReselecting)
 
 		table.state.selectedRow = dataIndex
 		table.state.selectedRows = make(map[int]bool) // Clear multi-select
@@ -105,27 +150,56 @@ func (h *DefaultMouseHandler) HandleCellClick(id widget.TableCellID, table *Tabl
 		if table.state.selectedRow >= 0 {
 			// Fire OnRowSelected callback if configured (skip during programmatic re-selection)
 			if !table.state.isReselecting && table.config.OnRowSelected != nil {
-				table.logger().Info(fmt.Sprintf("[CLICK] Calling OnRowSelected for row=%d", dataIndex))
+				table.logger().Debug("[CLICK] Calling OnRowSelected", "row", dataIndex)
 				table.config.OnRowSelected(dataIndex, table.data[dataIndex])
 			} else if table.state.isReselecting {
-				table.logger().Info(fmt.Sprintf("[CLICK] Skipping OnRowSelected (isReselecting=true) for row=%d", dataIndex))
+				table.logger().Debug("[CLICK] Skipping OnRowSelected while reselecting", "row", dataIndex)
 			}
 		}
 	}
 
 	// After selecting the cell, check if it's an interactive cell (checkbox or dropdown)
-	// and automatically activate it on mouse click (but not during keyboard navigation or re-selection)
-	if !table.state.isKeyboardNavigation && !table.state.isReselecting {
+	// and automatically activate it on mouse click (but not during keyboard navigation or re-selection,
+	// or when activation requires a deliberate second interaction)
+	if table.config.ActivateOnSingleClick && !table.state.isKeyboardNavigation && !table.state.isReselecting {
 		h.activateInteractiveCell(table, dataIndex, table.state.selectedCol)
 	}
 
 	// Skip focus request and refresh during programmatic re-selection (already done by caller)
 	if !table.state.isReselecting {
+		table.announceSelection()
 		table.RequestFocus()
-		table.table.Refresh()
+		table.refreshTable()
 	}
 }
 
+// selectRange selects the visible rows between the anchor row and row, inclusive and
+// in display order, skipping group headers and disabled rows. With add the range joins
+// the current selection instead of replacing it. Returns false if there is no anchor
+// row or it isn't visible (e.g. filtered out).
+func (h *DefaultMouseHandler) selectRange(table *Table, row int, add bool) bool {
+	from := slices.Index(table.state.visibleRows, table.state.anchorRow)
+	to := slices.Index(table.state.visibleRows, row)
+	if table.state.anchorRow < 0 || from < 0 || to < 0 {
+		return false
+	}
+	if from > to {
+		from, to = to, from
+	}
+
+	var rows []int
+	if add {
+		rows = table.state.GetSelectedRows()
+	}
+	for _, r := range table.state.visibleRows[from : to+1] {
+		if r >= 0 && r < len(table.data) && table.isRowSelectable(r) {
+			rows = append(rows, r)
+		}
+	}
+	table.state.SetSelectedRows(rows)
+	return true
+}
+
 // activateInteractiveCell checks if the clicked cell is a checkbox or dropdown
 // and automatically activates it (toggle checkbox or show popup menu)
 func (h *DefaultMouseHandler) activateInteractiveCell(table *Table, rowIndex, colIndex int) {
@@ -137,8 +211,8 @@ func (h *DefaultMouseHandler) activateInteractiveCell(table *Table, rowIndex, co
 		return
 	}
 
-	// Check if column is read-only
-	if table.isColumnReadOnly(colIndex) {
+	// Check if column is read-only or the row is disabled or full-width
+	if table.isColumnReadOnly(colIndex) || !table.isRowSelectable(rowIndex) || table.isFullWidthRow(rowIndex) {
 		return
 	}
 
@@ -178,22 +252,9 @@ func (h *DefaultMouseHandler) activateInteractiveCell(table *Table, rowIndex, co
 		// CRITICAL: Restore navigation state to the cell where checkbox was clicked
 		// The OnCellEdited callback may have triggered state changes
 		// But we want arrow keys to continue from where the checkbox click occurred
-		table.logger().Info(fmt.Sprintf("[CHECKBOX-MOUSE] Restoring navigation state from (%d,%d) to (%d,%d)",
-			table.state.selectedRow, table.state.selectedCol, rowIndex, colIndex))
-		table.state.selectedRow = rowIndex
-		table.state.selectedCol = colIndex
-
-		// Refresh the entire table to show updated value
-		// Note: We refresh the whole table instead of just the cell to avoid
-		// unintended side effects from RefreshItem (which can cause selection changes)
-		// We DON'T call Select() because that would trigger OnSelected callbacks
-		// and potentially change our navigation state. Our selectedRow/selectedCol
-		// remain unchanged, so arrow keys will work correctly from the current position.
-		if table.table != nil {
-			table.logger().Info(fmt.Sprintf("[REFRESH-MOUSE] Refreshing table after checkbox toggle, navigation state: row=%d col=%d", table.state.selectedRow, table.state.selectedCol))
-			table.table.Refresh()
-			table.logger().Info(fmt.Sprintf("[REFRESH-MOUSE] Table refreshed, navigation state preserved: row=%d col=%d", table.state.selectedRow, table.state.selectedCol))
-		}
+		table.logger().Debug("[CHECKBOX-MOUSE] Restoring navigation state", "fromRow", table.state.selectedRow, "fromCol", table.state.selectedCol,
+			"row", rowIndex, "col", colIndex)
+		table.moveSelectionTo(rowIndex, colIndex)
 	}
 }
 
@@ -206,21 +267,39 @@ func (h *DefaultMouseHandler) HandleHeaderClick(colIndex int, table *Table) {
 	actualColIndex := table.state.visibleColumns[colIndex]
 	col := table.config.Columns[actualColIndex]
 
-	// Only allow sorting if column is sortable
-	if !col.Sortable {
+	// Let the application claim the click (e.g. Alt-click opens a filter menu)
+	if table.config.OnHeaderClick != nil && table.config.OnHeaderClick(col.ID, currentKeyModifiers()) {
 		return
 	}
 
-	// Toggle sort direction if clicking same column, otherwise sort ascending
-	if table.state.sortColumn == actualColIndex {
-		table.state.sortAsc = !table.state.sortAsc
-	} else {
-		table.state.sortColumn = actualColIndex
-		table.state.sortAsc = true
+	// Only allow sorting if column is sortable
+	if !col.Sortable {
+		return
 	}
 
-	table.sortData()
-	table.logger().Info("[SORT] Calling table.Refresh after sort")
-	table.table.Refresh()
-	table.logger().Info("[SORT] table.Refresh completed")
+	// Toggle sort direction if clicking the sort column, otherwise sort ascending by the
+	// clicked column alone. With Shift held the column is added as the next sort key
+	// instead (or its direction toggled if it already is one), keeping the others.
+	// With the three-state cycle, a click on a descending column removes it from the sort.
+	// Like other sorts, this cancels an edit in progress and supersedes a running sort.
+	shift := table.isShiftPressed()
+	table.updateSort(func(keys []columnSort) []columnSort {
+		pos := sortKeyIndex(keys, actualColIndex)
+		if !shift {
+			if pos == 0 {
+				keys = keys[:1]
+			} else {
+				keys, pos = nil, -1
+			}
+		}
+		switch {
+		case pos < 0:
+			keys = append(keys, columnSort{column: actualColIndex, asc: true})
+		case !keys[pos].asc && table.config.HeaderSortCycle == SortCycleThreeState:
+			keys = slices.Delete(keys, pos, pos+1)
+		default:
+			keys[pos].asc = !keys[pos].asc
+		}
+		return keys
+	})
 }
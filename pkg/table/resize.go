@@ -0,0 +1,161 @@
+package table
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Manual column resizing without headers
+//
+// Fyne only offers drag-resize on its header row, so hiding headers
+// (ShowHeaders=false) removes the resize handles. With Config.EnableManualResize
+// set, a thin strip above the table marks each column divider and can be dragged
+// to resize columns (and double-clicked to auto-resize, if enabled). With headers
+// shown, the header row already provides resizing and the strip is not added.
+
+const (
+	resizeStripHeight  = 6  // Height of the divider strip in pixels
+	minDragColumnWidth = 20 // Narrowest width a drag can produce when MinWidth is unset
+)
+
+// columnResizeStrip is a thin bar with draggable column dividers
+type columnResizeStrip struct {
+	widget.BaseWidget
+	table *Table
+
+	dragCol        int     // Actual index of the column being resized, -1 if none
+	dragStartX     float32 // Pointer x at drag start
+	dragStartWidth float32 // Column width at drag start
+}
+
+// newColumnResizeStrip creates a resize strip for the table
+func newColumnResizeStrip(table *Table) *columnResizeStrip {
+	s := &columnResizeStrip{table: table, dragCol: -1}
+	s.ExtendBaseWidget(s)
+	return s
+}
+
+// createResizeStrip creates the resize strip if manual resizing is enabled and headers are hidden
+func (st *Table) createResizeStrip() {
+	if !st.config.EnableManualResize || st.config.ShowHeaders {
+		return
+	}
+	st.resizeStrip = newColumnResizeStrip(st)
+}
+
+// CreateRenderer implements fyne.Widget
+func (s *columnResizeStrip) CreateRenderer() fyne.WidgetRenderer {
+	return &columnResizeStripRenderer{strip: s}
+}
+
+// Cursor shows a horizontal resize cursor over the strip
+func (s *columnResizeStrip) Cursor() desktop.Cursor {
+	return desktop.HResizeCursor
+}
+
+// Dragged resizes the column whose divider the drag started on
+func (s *columnResizeStrip) Dragged(ev *fyne.DragEvent) {
+	st := s.table
+	if s.dragCol < 0 {
+		startX := ev.Position.X - ev.Dragged.DX
+		st.syncColumnWidthsFromTable() // Pick up widths changed by other means
+		s.dragCol = st.columnDividerAt(startX)
+		if s.dragCol < 0 {
+			return
+		}
+		s.dragStartX = startX
+		s.dragStartWidth = st.config.Columns[s.dragCol].Width
+		if s.dragStartWidth == 0 {
+			s.dragStartWidth = 100 // Default width (see columnDividerOffsets)
+		}
+	}
+
+	minWidth := st.config.Columns[s.dragCol].MinWidth
+	if minWidth <= 0 {
+		minWidth = minDragColumnWidth
+	}
+	width := s.dragStartWidth + ev.Position.X - s.dragStartX
+	if width < minWidth {
+		width = minWidth
+	}
+	st.setColumnWidth(s.dragCol, width)
+	s.Refresh()
+}
+
+// DragEnd finishes a resize, reports it and saves the new widths
+func (s *columnResizeStrip) DragEnd() {
+	if s.dragCol < 0 {
+		return
+	}
+	colIndex := s.dragCol
+	s.dragCol = -1
+	s.table.notifyColumnsResized([]int{colIndex})
+}
+
+// DoubleTapped auto-resizes the column whose divider was double-clicked
+func (s *columnResizeStrip) DoubleTapped(ev *fyne.PointEvent) {
+	st := s.table
+	if !st.config.EnableDoubleClickResize {
+		return
+	}
+	st.syncColumnWidthsFromTable()
+	if colIndex := st.columnDividerAt(ev.Position.X); colIndex >= 0 {
+		st.autoResizeColumn(colIndex)
+		s.Refresh()
+	}
+}
+
+// setColumnWidth applies a new width to a column in the config and the table widget
+func (st *Table) setColumnWidth(colIndex int, width float32) {
+	st.config.Columns[colIndex].Width = width
+	if st.table == nil {
+		return
+	}
+	if displayIdx := indexOf(st.state.visibleColumns, colIndex); displayIdx >= 0 {
+		st.table.SetColumnWidth(displayIdx, width)
+	}
+}
+
+// columnResizeStripRenderer draws a short mark at each column divider
+type columnResizeStripRenderer struct {
+	strip   *columnResizeStrip
+	marks   []*canvas.Rectangle
+	objects []fyne.CanvasObject
+}
+
+func (r *columnResizeStripRenderer) Layout(size fyne.Size) {
+	offsets := r.strip.table.columnDividerOffsets()
+	for len(r.marks) < len(offsets) {
+		r.marks = append(r.marks, canvas.NewRectangle(theme.Color(theme.ColorNameSeparator)))
+	}
+	r.marks = r.marks[:len(offsets)]
+
+	markWidth := theme.SeparatorThicknessSize() * 2
+	r.objects = r.objects[:0]
+	for i, x := range offsets {
+		r.marks[i].Move(fyne.NewPos(x-markWidth/2, 0))
+		r.marks[i].Resize(fyne.NewSize(markWidth, size.Height))
+		r.objects = append(r.objects, r.marks[i])
+	}
+}
+
+func (r *columnResizeStripRenderer) MinSize() fyne.Size {
+	return fyne.NewSize(0, resizeStripHeight)
+}
+
+func (r *columnResizeStripRenderer) Refresh() {
+	for _, mark := range r.marks {
+		mark.FillColor = theme.Color(theme.ColorNameSeparator)
+	}
+	r.Layout(r.strip.Size())
+	canvas.Refresh(r.strip)
+}
+
+func (r *columnResizeStripRenderer) Objects() []fyne.CanvasObject {
+	return r.objects
+}
+
+func (r *columnResizeStripRenderer) Destroy() {}
@@ -0,0 +1,112 @@
+package table
+
+import (
+	"fmt"
+	"slices"
+)
+
+// View snapshots
+//
+// TableStateSnapshot covers sort, filter and selection only. TableSnapshot adds the
+// column layout (order, width and visibility) so a whole view can be saved and
+// restored in memory, e.g. for "save view" / "reset view" buttons.
+
+// ColumnLayout is one column's layout in a TableSnapshot
+type ColumnLayout struct {
+	ID      string
+	Width   float32 // 0 = the column had no explicit width
+	Visible bool
+}
+
+// TableSnapshot captures a table's column layout along with its sort, filter and
+// selection state
+type TableSnapshot struct {
+	Columns []ColumnLayout      // Every column, hidden ones included, in display order
+	State   *TableStateSnapshot // Sort column indexes refer to the order of Columns
+}
+
+// Snapshot captures the current column layout, sort, filter and selection.
+// Widths include any the user changed by dragging.
+func (st *Table) Snapshot() *TableSnapshot {
+	st.syncColumnWidthsFromTable()
+
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	columns := make([]ColumnLayout, len(st.config.Columns))
+	for i, col := range st.config.Columns {
+		columns[i] = ColumnLayout{ID: col.ID, Width: col.Width, Visible: !col.Hidden}
+	}
+	return &TableSnapshot{Columns: columns, State: st.state.Snapshot()}
+}
+
+// Diff returns the IDs of columns whose position, width or visibility differs
+// between s and other, in the order of s. Columns missing from other are included.
+// Sort, filter and selection are not compared.
+func (s *TableSnapshot) Diff(other *TableSnapshot) []string {
+	var changed []string
+	for i, col := range s.Columns {
+		j := slices.IndexFunc(other.Columns, func(c ColumnLayout) bool { return c.ID == col.ID })
+		if j != i || other.Columns[j] != col {
+			changed = append(changed, col.ID)
+		}
+	}
+	return changed
+}
+
+// ApplySnapshot restores the column layout, sort, filter and selection captured by
+// Snapshot. The data is re-sorted by the captured sort keys, and a selection that
+// no longer fits the data is cleared. Returns an error, leaving the table unchanged,
+// if the snapshot doesn't list exactly the table's columns.
+func (st *Table) ApplySnapshot(snap *TableSnapshot) error {
+	if snap == nil || snap.State == nil {
+		return &TableError{Op: "apply snapshot", Err: fmt.Errorf("incomplete snapshot")}
+	}
+	defer st.deferNotify()() // One notification for the whole view
+	order := make([]string, len(snap.Columns))
+	for i, col := range snap.Columns {
+		order[i] = col.ID
+	}
+	if err := st.SetColumnOrder(order); err != nil {
+		return err
+	}
+
+	// Set every width before changing visibility, which applies the widths of the
+	// visible columns to the table widget
+	for i, col := range snap.Columns {
+		st.config.Columns[i].Width = col.Width
+	}
+	for _, col := range snap.Columns {
+		st.SetColumnVisibility(col.ID, col.Visible)
+	}
+
+	st.mu.Lock()
+	st.state.RestoreFromSnapshot(snap.State)
+	if st.state.sortColumn >= len(st.config.Columns) {
+		st.state.sortColumn = -1
+	}
+	st.state.thenSortBy = slices.DeleteFunc(st.state.thenSortBy, func(key columnSort) bool {
+		return key.column < 0 || key.column >= len(st.config.Columns)
+	})
+	if st.state.sortColumn < 0 {
+		st.clearSort() // Back to the order the data was set in
+	} else {
+		st.sortData()
+	}
+	if st.state.selectedRow >= len(st.data) {
+		st.state.selectedRow = -1
+	}
+	for row := range st.state.selectedRows {
+		if row < 0 || row >= len(st.data) {
+			delete(st.state.selectedRows, row)
+		}
+	}
+	st.rebuildVisibleRows()
+	st.mu.Unlock()
+
+	st.updateRowCount()
+	if st.table != nil {
+		st.refreshTable()
+	}
+	st.notifyListeners()
+	return nil
+}
@@ -2,6 +2,7 @@ package table
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 
 	"fyne.io/fyne/v2/widget"
@@ -12,35 +13,65 @@ import (
 type TableState struct {
 	// View state
 	visibleColumns []int // Indices of visible columns (filters out hidden columns)
-	visibleRows    []int // Indices of visible rows (after tree filtering)
+	visibleRows    []int // Indices of visible rows (after tree filtering; group headers are < -1)
 
 	// Sort state
-	sortColumn int  // -1 = no sort, otherwise column index
-	sortAsc    bool // true = ascending, false = descending
+	sortColumn int          // -1 = no sort, otherwise column index of the primary sort key
+	sortAsc    bool         // true = ascending, false = descending
+	thenSortBy []columnSort // Further sort keys applied on ties, highest priority first
 
 	// Focus state
 	hasFocus bool // true when table has keyboard focus
 
 	// Filter state
-	filterText          string // Current filter text
-	filterRegex         bool   // true = use regex matching, false = plain text
-	filterCaseSensitive bool   // true = case-sensitive matching, false = case-insensitive
+	filterText          string                      // Current filter text
+	filterRegex         bool                        // true = use regex matching, false = plain text
+	filterCaseSensitive bool                        // true = case-sensitive matching, false = case-insensitive
+	filterError         error                       // Error from the last rejected filter (nil = filter accepted)
+	columnFilters       map[string]columnFilter     // Per-column filters keyed by column ID (all must match)
+	rowFilter           func(data interface{}) bool // Custom row predicate (nil = none), ANDed with the other filters
+
+	// Grouping state
+	groups          []rowGroup      // Groups from the last rebuild (GroupByColumn set only)
+	collapsedGroups map[string]bool // Group values whose rows are hidden
 
 	// Selection state
 	selectedRow  int          // -1 = no selection, otherwise data row index (single-select mode)
 	selectedCol  int          // -1 = no selection, otherwise actual column index (only used when RowSelectOnlyMode=false)
 	selectedRows map[int]bool // Multi-select mode: map of selected data row indices
+	anchorRow    int          // -1 = none, otherwise data row index Shift-click selects a range from
 
 	// Edit state (row/col/value only - widget reference stays in Table)
 	editingRow   int    // -1 = not editing
 	editingCol   int    // -1 = not editing
 	editingValue string // Original value before edit
 
+	// Detail rows (ToggleRowDetail)
+	detailRows map[int]bool // Data row indices whose detail is open
+
 	// Navigation state
 	isKeyboardNavigation bool // true when navigating with arrow keys (don't auto-activate)
 	isReselecting        bool // true when re-selecting cell after refresh (don't fire callbacks)
 }
 
+// columnSort is a sort key: a column index and direction
+type columnSort struct {
+	column int  // Column index
+	asc    bool // true = ascending, false = descending
+}
+
+// columnFilter is a filter applied to a single column's values
+type columnFilter struct {
+	op    FilterOperator // How the value is matched
+	value string         // Value to match against
+}
+
+// rowGroup is a run of rows sharing the same GroupByColumn value
+type rowGroup struct {
+	value string // Group value shown in the header
+	rows  []int  // Data indices of the group's rows (including collapsed ones)
+}
+
 // NewTableState creates a new TableState with default values
 func NewTableState() *TableState {
 	return &TableState{
@@ -52,6 +83,7 @@ func NewTableState() *TableState {
 		selectedRow:    -1,
 		selectedCol:    -1,
 		selectedRows:   make(map[int]bool),
+		anchorRow:      -1,
 		editingRow:     -1,
 		editingCol:     -1,
 	}
@@ -71,6 +103,7 @@ func (s *TableState) ClearSelection() {
 	s.selectedRow = -1
 	s.selectedCol = -1
 	s.selectedRows = make(map[int]bool)
+	s.anchorRow = -1
 }
 
 // GetSelectedRows returns a slice of selected row indices (works for both single and multi-select)
@@ -136,6 +169,34 @@ func (s *TableState) GetSelectionCount() int {
 	return 0
 }
 
+// ClampToRowCount validates selection and edit state against a new row count.
+// A single selection past the end is clamped to the last row (or cleared when
+// there are no rows), out-of-range multi-select entries are dropped, and an
+// edit on a row that no longer exists is cancelled.
+func (s *TableState) ClampToRowCount(rowCount int) {
+	if s.selectedRow >= rowCount {
+		if rowCount > 0 {
+			s.selectedRow = rowCount - 1
+		} else {
+			s.selectedRow = -1
+			s.selectedCol = -1
+		}
+	}
+
+	for row := range s.selectedRows {
+		if row >= rowCount {
+			delete(s.selectedRows, row)
+		}
+	}
+	if s.anchorRow >= rowCount {
+		s.anchorRow = -1
+	}
+
+	if s.editingRow >= rowCount {
+		s.ClearEdit()
+	}
+}
+
 // ========================================
 // Edit State Methods
 // ========================================
@@ -158,7 +219,7 @@ func (s *TableState) ClearEdit() {
 
 // HasFilter returns true if a filter is active
 func (s *TableState) HasFilter() bool {
-	return s.filterText != ""
+	return s.filterText != "" || len(s.columnFilters) > 0 || s.rowFilter != nil
 }
 
 // ClearFilter clears the filter state
@@ -166,6 +227,8 @@ func (s *TableState) ClearFilter() {
 	s.filterText = ""
 	s.filterRegex = false
 	s.filterCaseSensitive = false
+	s.filterError = nil
+	s.columnFilters = nil
 }
 
 // ========================================
@@ -181,6 +244,26 @@ func (s *TableState) IsSorted() bool {
 func (s *TableState) ClearSort() {
 	s.sortColumn = -1
 	s.sortAsc = true
+	s.thenSortBy = nil
+}
+
+// sortKeys returns the sort keys in priority order: the primary sort column followed
+// by the tie-breaking keys. Empty when not sorted.
+func (s *TableState) sortKeys() []columnSort {
+	if s.sortColumn < 0 {
+		return nil
+	}
+	return append([]columnSort{{column: s.sortColumn, asc: s.sortAsc}}, s.thenSortBy...)
+}
+
+// setSortKeys replaces the sort keys; the first is the primary sort column
+func (s *TableState) setSortKeys(keys []columnSort) {
+	if len(keys) == 0 {
+		s.ClearSort()
+		return
+	}
+	s.sortColumn, s.sortAsc = keys[0].column, keys[0].asc
+	s.thenSortBy = slices.Clone(keys[1:])
 }
 
 // ========================================
@@ -207,13 +290,18 @@ func (s *TableState) Reset() {
 	s.visibleRows = []int{}
 	s.sortColumn = -1
 	s.sortAsc = true
+	s.thenSortBy = nil
 	s.hasFocus = false
 	s.filterText = ""
 	s.filterRegex = false
 	s.filterCaseSensitive = false
+	s.filterError = nil
+	s.columnFilters = nil
+	s.rowFilter = nil
 	s.selectedRow = -1
 	s.selectedCol = -1
 	s.selectedRows = make(map[int]bool)
+	s.anchorRow = -1
 	s.editingRow = -1
 	s.editingCol = -1
 	s.editingValue = ""
@@ -246,7 +334,7 @@ func (s *TableState) GetVisibleColumns() []int {
 	return result
 }
 
-// GetVisibleRows returns a copy of visible row indices
+// GetVisibleRows returns a copy of visible row indices (group header rows are negative)
 func (s *TableState) GetVisibleRows() []int {
 	result := make([]int, len(s.visibleRows))
 	copy(result, s.visibleRows)
@@ -316,6 +404,7 @@ func (s *TableState) HasFocus() bool {
 type TableStateSnapshot struct {
 	SortColumn          int
 	SortAsc             bool
+	ThenSortBy          []SnapshotSortKey // Tie-breaking sort keys after SortColumn, highest priority first
 	FilterText          string
 	FilterRegex         bool
 	FilterCaseSensitive bool
@@ -324,6 +413,12 @@ type TableStateSnapshot struct {
 	SelectedRows        map[int]bool // Multi-select state
 }
 
+// SnapshotSortKey is a tie-breaking sort key of a TableStateSnapshot
+type SnapshotSortKey struct {
+	Column    int // Column index
+	Ascending bool
+}
+
 // Snapshot creates a snapshot of the current state
 func (s *TableState) Snapshot() *TableStateSnapshot {
 	// Deep copy selectedRows map
@@ -332,9 +427,15 @@ func (s *TableState) Snapshot() *TableStateSnapshot {
 		selectedRowsCopy[row] = val
 	}
 
+	var thenSortBy []SnapshotSortKey
+	for _, key := range s.thenSortBy {
+		thenSortBy = append(thenSortBy, SnapshotSortKey{Column: key.column, Ascending: key.asc})
+	}
+
 	return &TableStateSnapshot{
 		SortColumn:          s.sortColumn,
 		SortAsc:             s.sortAsc,
+		ThenSortBy:          thenSortBy,
 		FilterText:          s.filterText,
 		FilterRegex:         s.filterRegex,
 		FilterCaseSensitive: s.filterCaseSensitive,
@@ -348,6 +449,10 @@ func (s *TableState) Snapshot() *TableStateSnapshot {
 func (s *TableState) RestoreFromSnapshot(snap *TableStateSnapshot) {
 	s.sortColumn = snap.SortColumn
 	s.sortAsc = snap.SortAsc
+	s.thenSortBy = nil
+	for _, key := range snap.ThenSortBy {
+		s.thenSortBy = append(s.thenSortBy, columnSort{column: key.Column, asc: key.Ascending})
+	}
 	s.filterText = snap.FilterText
 	s.filterRegex = snap.FilterRegex
 	s.filterCaseSensitive = snap.FilterCaseSensitive
@@ -1,6 +1,7 @@
 package table
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -293,6 +294,7 @@ func TestTableState_Snapshot(t *testing.T) {
 	// Set state
 	state.sortColumn = 2
 	state.sortAsc = false
+	state.thenSortBy = []columnSort{{column: 0, asc: true}, {column: 1, asc: false}}
 	state.filterText = "test"
 	state.filterRegex = true
 	state.filterCaseSensitive = true
@@ -305,6 +307,7 @@ func TestTableState_Snapshot(t *testing.T) {
 	// Modify state
 	state.sortColumn = 0
 	state.sortAsc = true
+	state.thenSortBy = nil
 	state.filterText = "modified"
 	state.selectedRow = 10
 
@@ -318,6 +321,9 @@ func TestTableState_Snapshot(t *testing.T) {
 	if state.sortAsc {
 		t.Error("Expected sortAsc = false after restore")
 	}
+	if want := []columnSort{{column: 0, asc: true}, {column: 1, asc: false}}; !slices.Equal(state.thenSortBy, want) {
+		t.Errorf("Expected thenSortBy = %v after restore, got %v", want, state.thenSortBy)
+	}
 	if state.filterText != "test" {
 		t.Errorf("Expected filterText = 'test' after restore, got %q", state.filterText)
 	}
@@ -347,3 +353,37 @@ func TestTableState_String(t *testing.T) {
 		t.Error("Expected non-empty string representation")
 	}
 }
+
+func TestTableState_ClampToRowCount(t *testing.T) {
+	state := NewTableState()
+	state.selectedRow = 7
+	state.selectedCol = 2
+	state.selectedRows = map[int]bool{1: true, 4: true, 9: true}
+	state.editingRow = 6
+	state.editingCol = 1
+	state.editingValue = "stale"
+
+	state.ClampToRowCount(5)
+
+	if state.selectedRow != 4 {
+		t.Errorf("Expected selectedRow clamped to 4, got %d", state.selectedRow)
+	}
+	if state.selectedCol != 2 {
+		t.Errorf("Expected selectedCol unchanged at 2, got %d", state.selectedCol)
+	}
+	if len(state.selectedRows) != 2 || !state.selectedRows[1] || !state.selectedRows[4] {
+		t.Errorf("Expected selectedRows {1, 4}, got %v", state.selectedRows)
+	}
+	if state.IsEditing() {
+		t.Error("Expected edit on removed row to be cleared")
+	}
+
+	// Shrinking to zero rows clears the selection entirely
+	state.ClampToRowCount(0)
+	if state.HasSelection() {
+		t.Errorf("Expected no selection with zero rows, got %s", state.String())
+	}
+	if state.selectedCol != -1 {
+		t.Errorf("Expected selectedCol = -1 with zero rows, got %d", state.selectedCol)
+	}
+}
@@ -1,28 +1,38 @@
 package table
 
 import (
+	"context"
 	"fmt"
+	"image/color"
 	"math"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
+	"unicode/utf8"
 	"unsafe"
 
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
 	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/lang"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
-	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 )
 
 // Ensure Table implements required interfaces
 var _ fyne.Widget = (*Table)(nil)
 var _ fyne.Focusable = (*Table)(nil)
+var _ fyne.Tabbable = (*keyboardForwardingTable)(nil)
 
 // escapeableEntry is a custom Entry widget that handles ESC and Enter keys
 type escapeableEntry struct {
@@ -62,10 +72,30 @@ func (e *escapeableEntry) TypedKey(key *fyne.KeyEvent) {
 type keyboardForwardingTable struct {
 	*widget.Table
 	onTypedKey      func(*fyne.KeyEvent)
+	onTypedRune     func(rune)
 	onTypedShortcut func(fyne.Shortcut)
 	onFocusGain     func()
 	onFocusLost     func()
 	onDoubleTap     func(*fyne.PointEvent)
+	onDragEnd       func()
+	onScrolled      func()
+	acceptsTab      func() bool
+}
+
+// ScrollTo scrolls the base table and notifies the parent Table
+func (t *keyboardForwardingTable) ScrollTo(id widget.TableCellID) {
+	t.Table.ScrollTo(id)
+	if t.onScrolled != nil {
+		t.onScrolled()
+	}
+}
+
+// Select selects a cell (scrolling it into view) and notifies the parent Table
+func (t *keyboardForwardingTable) Select(id widget.TableCellID) {
+	t.Table.Select(id)
+	if t.onScrolled != nil {
+		t.onScrolled()
+	}
 }
 
 // TypedKey forwards keyboard events to the parent Table handler
@@ -77,6 +107,13 @@ func (t *keyboardForwardingTable) TypedKey(key *fyne.KeyEvent) {
 	}
 }
 
+// TypedRune forwards typed characters to parent Table (for type-to-edit)
+func (t *keyboardForwardingTable) TypedRune(r rune) {
+	if t.onTypedRune != nil {
+		t.onTypedRune(r)
+	}
+}
+
 // TypedShortcut forwards shortcut events to parent Table
 func (t *keyboardForwardingTable) TypedShortcut(shortcut fyne.Shortcut) {
 	// Note: widget.Table doesn't have TypedShortcut, so we only forward to our handler
@@ -105,6 +142,23 @@ func (t *keyboardForwardingTable) FocusLost() {
 	}
 }
 
+// AcceptsTab implements fyne.Tabbable so Tab reaches the table while there is
+// another cell to move to; otherwise Fyne moves focus to the next widget
+func (t *keyboardForwardingTable) AcceptsTab() bool {
+	if t.acceptsTab != nil {
+		return t.acceptsTab()
+	}
+	return false
+}
+
+// DragEnd ends a header drag (column resize) and notifies the parent Table
+func (t *keyboardForwardingTable) DragEnd() {
+	t.Table.DragEnd()
+	if t.onDragEnd != nil {
+		t.onDragEnd()
+	}
+}
+
 // DoubleTapped forwards double-tap events to parent Table
 func (t *keyboardForwardingTable) DoubleTapped(ev *fyne.PointEvent) {
 	// Forward to our wrapper for column auto-resize handling
@@ -117,14 +171,50 @@ func (t *keyboardForwardingTable) DoubleTapped(ev *fyne.PointEvent) {
 type Table struct {
 	widget.BaseWidget
 
-	config *Config
-	data   []interface{}
+	config       *Config
+	data         []interface{}
+	unsortedData []interface{} // Data in the order it was set, restored when sorting is cleared
+
+	// mu guards data and row state (visible rows, selection, grouping) so SetData
+	// may run off the UI thread while cells render. Write paths take the lock;
+	// the cell render path takes the read lock. Fyne calls (Refresh, Select, ...)
+	// and user callbacks are never made while holding the write lock.
+	mu sync.RWMutex
 
 	// Internal widget reference
-	table *keyboardForwardingTable
+	table        *keyboardForwardingTable
+	hookedScroll *container.Scroll // Table scroll container whose OnScrolled is chained (see hookTableScroll)
+
+	// Detail rows (see detail.go)
+	detailObjects    map[int]fyne.CanvasObject // Detail content by data index for open details
+	detailHeightRows []int                     // Table rows currently enlarged for a detail
+	detailOverlay    *detailOverlay            // Draws open details across their rows (nil = no DetailRenderer)
+
+	// Batch updates (BeginUpdate/EndUpdate): refreshes are deferred while updateDepth > 0.
+	// Guarded by listenersMu, which notifyListeners holds when checking updateDepth.
+	updateDepth    int
+	refreshPending bool
+
+	// Filter and sort requests: the latest of each kind wins and cancels the one of that
+	// kind in flight (see beginViewOp)
+	viewOpMu sync.Mutex
+	viewOps  [viewOpKinds]viewOp
+
+	// Change listeners (see listener.go)
+	listenersMu   sync.Mutex
+	listeners     []binding.DataListener
+	notifyDepth   int   // Operations in progress that hold notifications (see deferNotify)
+	notifyPending bool  // A change was made while notifications were held
+	lastSelection []int // Selected rows last reported to Config.OnSelectionChanged
+
+	// Search text matches in cells, for Config.HighlightMatches (nil = none); rebuilt
+	// with the visible rows
+	searchHighlight *regexp.Regexp
 
 	// Edit widget reference (separate from state as it's a UI object)
-	editingEntry *widget.Entry
+	editingEntry  *escapeableEntry  // Created once per edit and reused across refreshes
+	editingWidget fyne.CanvasObject // Check or Select of an EditBool / EditSelect edit, used instead of editingEntry
+	editTypedText string            // Text typed to start the pending edit (type-to-edit), replaces the value in the new entry
 
 	// Filter UI widgets (only created if ShowSearch is true)
 	filterEntry           *widget.Entry
@@ -138,6 +228,13 @@ type Table struct {
 	filterTopContainer    *fyne.Container
 	filterVisible         bool
 
+	// Row count status line (only created if ShowRowCount is true)
+	rowCountLabel   *widget.Label
+	rowCountPrinter *message.Printer // Formats counts for the system locale
+
+	// Column resize strip (only created if EnableManualResize is set and headers are hidden)
+	resizeStrip *columnResizeStrip
+
 	// Runtime state
 	state *TableState
 
@@ -148,7 +245,18 @@ type Table struct {
 }
 
 // NewTable creates a new table
+//
+// An invalid configuration (see Config.Validate) is logged as an error and still
+// produces a table; use NewTableChecked to get the validation error instead.
 func NewTable(config *Config) *Table {
+	if err := config.Validate(); err != nil {
+		logger := config.Logger
+		if logger == nil {
+			logger = NoopLogger{}
+		}
+		logger.Error(fmt.Sprintf("[TABLE] Invalid configuration: %v", err))
+	}
+
 	st := &Table{
 		config:       config,
 		data:         []interface{}{},
@@ -165,28 +273,42 @@ func NewTable(config *Config) *Table {
 
 	st.createTable()
 	st.createFilterUI()
+	st.createRowCountLabel()
+	st.createResizeStrip()
+	st.createDetailOverlay()
 	st.ExtendBaseWidget(st) // Must be called AFTER createFilterUI so renderer sees filter section
 
 	// Force initial refresh to ensure all rows display correctly
 	if st.table != nil {
-		st.table.Refresh()
+		st.refreshTable()
 	}
 
 	// Verify ShowHeaderColumn is still false after initialization
 	if st.table != nil {
-		st.logger().Info(fmt.Sprintf("[TABLE] Post-init verification: ShowHeaderColumn=%v",
-			st.table.ShowHeaderColumn))
+		st.logger().Debug("[TABLE] Post-init verification", "showHeaderColumn", st.table.ShowHeaderColumn)
 	}
 
 	return st
 }
 
-// logger returns the configured logger or a default one
+// NewTableChecked creates a new table widget after validating the configuration.
+// It returns the validation error, and no table, if the configuration is invalid.
+func NewTableChecked(config *Config) (*Table, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return NewTable(config), nil
+}
+
+// logger returns the configured logger or a default one, filtered by Config.LogLevel
 func (st *Table) logger() Logger {
-	if st.config.Logger != nil {
-		return st.config.Logger
+	if st.config.Logger == nil {
+		return NoopLogger{}
 	}
-	return NoopLogger{}
+	if st.config.LogLevel > LogLevelDebug {
+		return levelFilterLogger{logger: st.config.Logger, level: st.config.LogLevel}
+	}
+	return st.config.Logger
 }
 
 // isColumnReadOnly checks if a column is marked as read-only (non-activatable)
@@ -204,31 +326,35 @@ func (st *Table) createTable() {
 		st.tableCreateCell,
 		st.tableUpdateCell,
 	)
+	baseTable.CreateHeader = st.createFyneHeader
+	baseTable.UpdateHeader = st.updateFyneHeader
 
 	// Wrap the table to forward keyboard and focus events
 	st.table = &keyboardForwardingTable{
 		Table:           baseTable,
 		onTypedKey:      st.TypedKey,
+		onTypedRune:     st.TypedRune,
 		onTypedShortcut: st.TypedShortcut,
 		onFocusGain:     st.FocusGained,
 		onFocusLost:     st.FocusLost,
 		onDoubleTap:     st.handleDoubleTap,
+		onDragEnd:       st.handleHeaderDragEnd,
+		onScrolled:      st.handleTableScrolled,
+		acceptsTab:      st.AcceptsTab,
 	}
 
 	// OnSelected is triggered by single click in Fyne
 	st.table.OnSelected = func(id widget.TableCellID) {
-		st.logger().Info(fmt.Sprintf("[ONSELECTED] Callback triggered: id={Row:%d,Col:%d} isReselecting=%v",
-			id.Row, id.Col, st.state.isReselecting))
+		st.logger().Debug("[ONSELECTED] Callback triggered", "row", id.Row, "col", id.Col, "isReselecting", st.state.isReselecting)
 		st.handleCellClick(id)
-		st.logger().Info(fmt.Sprintf("[ONSELECTED] Callback completed: isReselecting=%v", st.state.isReselecting))
+		st.logger().Debug("[ONSELECTED] Callback completed", "isReselecting", st.state.isReselecting)
 	}
 
 	// Make header row sticky (doesn't scroll)
 	st.table.StickyRowCount = 1
 	st.table.ShowHeaderRow = st.config.ShowHeaders // Control header visibility
 	st.table.ShowHeaderColumn = false              // Hide Fyne's default A-D column labels
-	st.logger().Info(fmt.Sprintf("[TABLE] ShowHeaderColumn set to false, ShowHeaderRow=%v",
-		st.config.ShowHeaders))
+	st.logger().Debug("[TABLE] ShowHeaderColumn set to false", "showHeaderRow", st.config.ShowHeaders)
 
 	// Disable manual column resizing if configured
 	// Note: Fyne doesn't expose a direct API to disable manual resize,
@@ -244,8 +370,8 @@ func (st *Table) createTable() {
 	}
 
 	// Set row heights
-	if st.config.HeaderHeight > 0 {
-		st.table.SetRowHeight(0, st.config.HeaderHeight)
+	if height := st.config.headerHeight(); height > 0 {
+		st.table.SetRowHeight(0, height)
 	}
 	// Note: Fyne doesn't support per-row heights easily, default row height applies to all data rows
 }
@@ -253,11 +379,11 @@ func (st *Table) createTable() {
 // createFilterUI creates the search/filter UI controls
 func (st *Table) createFilterUI() {
 	if !st.config.ShowSearch {
-		st.logger().Info("[FILTER] ShowSearch is false, skipping filter UI creation")
+		st.logger().Debug("[FILTER] ShowSearch is false, skipping filter UI creation")
 		return
 	}
 
-	st.logger().Info("[FILTER] Creating filter UI widgets")
+	st.logger().Debug("[FILTER] Creating filter UI widgets")
 	st.filterVisible = false // Start with filter hidden (can be toggled via external controls)
 
 	// Create filter entry with placeholder
@@ -268,10 +394,20 @@ func (st *Table) createFilterUI() {
 	}
 	st.filterEntry.SetPlaceHolder(placeholder)
 
+	// Flag invalid regex patterns on the entry (previous results stay visible)
+	st.filterEntry.Validator = func(text string) error {
+		if st.regexCheckbox == nil || !st.regexCheckbox.Checked || text == "" {
+			return nil
+		}
+		_, err := compileFilterRegex(text, st.state.filterCaseSensitive)
+		return err
+	}
+
 	// Create regex checkbox (forward declare needed for filterEntry callback)
 	st.regexCheckbox = widget.NewCheck("Regex", func(checked bool) {
 		if st.filterEntry != nil {
-			st.SetFilter(st.filterEntry.Text, checked)
+			_ = st.SetFilter(st.filterEntry.Text, checked)
+			st.filterEntry.SetValidationError(st.GetFilterError())
 			st.RequestFocus()
 		}
 	})
@@ -293,13 +429,23 @@ func (st *Table) createFilterUI() {
 		}
 	})
 
+	// Without searchable columns the box would do nothing: grey it out and say why
+	// (Fyne has no tooltips, so the placeholder carries the explanation)
+	if len(st.searchColumns()) == 0 {
+		st.filterEntry.SetPlaceHolder("Search unavailable: no searchable columns")
+		st.filterEntry.Disable()
+		st.regexCheckbox.Disable()
+		st.caseSensitiveCheckbox.Disable()
+		st.clearFilterBtn.Disable()
+	}
+
 	// Wire up filter entry callbacks
 	st.filterEntry.OnChanged = func(text string) {
 		useRegex := false
 		if st.regexCheckbox != nil {
 			useRegex = st.regexCheckbox.Checked
 		}
-		st.SetFilter(text, useRegex)
+		_ = st.SetFilter(text, useRegex) // Validation error is shown by the entry's Validator
 	}
 	st.filterEntry.OnSubmitted = func(text string) {
 		st.RequestFocus()
@@ -329,7 +475,20 @@ func (st *Table) createFilterUI() {
 	st.filterSection = container.NewVBox()   // Start empty since filterVisible = false
 	st.filterTopContainer = st.filterSection // For backwards compatibility
 
-	st.logger().Info(fmt.Sprintf("[FILTER] Filter UI created: filterSection=%v, checkboxWithBg=%v", st.filterSection != nil, st.checkboxWithBg != nil))
+	st.logger().Debug("[FILTER] Filter UI created", "filterSection", st.filterSection != nil, "checkboxWithBg", st.checkboxWithBg != nil)
+}
+
+// searchColumns returns the IDs of the columns the search text is matched against:
+// every visible column with Config.SearchAllColumns, otherwise Config.FilterColumns
+func (st *Table) searchColumns() []string {
+	if !st.config.SearchAllColumns {
+		return st.config.FilterColumns
+	}
+	ids := make([]string, 0, len(st.state.visibleColumns))
+	for _, colIndex := range st.state.visibleColumns {
+		ids = append(ids, st.config.Columns[colIndex].ID)
+	}
+	return ids
 }
 
 // ========================================
@@ -339,20 +498,263 @@ func (st *Table) createFilterUI() {
 // TypedKey handles keyboard events - delegates to KeyHandler
 func (st *Table) TypedKey(key *fyne.KeyEvent) {
 	if st.KeyHandler != nil {
-		st.KeyHandler.HandleKey(key, st)
+		st.notifyInput(func() { st.KeyHandler.HandleKey(key, st) })
 	}
 }
 
 // TypedShortcut handles keyboard shortcuts - delegates to KeyHandler
 func (st *Table) TypedShortcut(shortcut fyne.Shortcut) {
 	if st.KeyHandler != nil {
-		st.KeyHandler.HandleShortcut(shortcut, st)
+		st.notifyInput(func() { st.KeyHandler.HandleShortcut(shortcut, st) })
 	}
 }
 
-// TypedRune implements Focusable interface
+// TypedRune implements Focusable interface. With Config.TypeToEdit, typing a
+// printable character on a selected editable cell starts an edit that replaces
+// the cell's value with the typed character. Checkbox and popup cells ignore runes.
 func (st *Table) TypedRune(r rune) {
-	// Not handling typed runes currently
+	if !st.config.TypeToEdit || st.state.IsEditing() || !unicode.IsPrint(r) || unicode.IsSpace(r) {
+		return // Space is handled by TypedKey (activate cell)
+	}
+
+	row, colIndex := st.state.selectedRow, st.state.selectedCol
+	if row < 0 || row >= len(st.data) || colIndex < 0 || colIndex >= len(st.config.Columns) {
+		return
+	}
+	col := st.config.Columns[colIndex]
+	if !col.Editable || col.EditType == EditBool || col.EditType == EditSelect || col.ShowCheckbox || col.PopupOptions != nil || st.isColumnReadOnly(colIndex) {
+		return
+	}
+
+	st.editTypedText = string(r)
+	st.startEdit(row, colIndex)
+	if !st.state.IsEditing() {
+		st.editTypedText = ""
+	}
+}
+
+// AcceptsTab reports whether a Tab press should be handled by the table.
+// Tab moves between cells until the last (or, with Shift, the first) cell is
+// reached, then lets focus leave the table. With Config.TabMovesFocusOut set,
+// Tab always leaves the table.
+func (st *Table) AcceptsTab() bool {
+	if st.state.IsEditing() {
+		return false
+	}
+	forward := !st.isShiftPressed()
+	if forward && st.config.FocusNext != nil {
+		return true // Leaving forwards goes to FocusNext (see handleTabKey)
+	}
+	if st.config.TabMovesFocusOut {
+		return false
+	}
+	_, _, ok := st.nextTabCell(forward)
+	return ok
+}
+
+// nextTabCell returns the cell Tab (forward) or Shift+Tab (backward) moves to.
+// Cells are visited in reading order over visible rows and columns; in
+// RowSelectOnlyMode Tab steps by row. ok is false when there is nowhere to go.
+func (st *Table) nextTabCell(forward bool) (row int, col int, ok bool) {
+	rows := st.selectableRows()
+	cols := st.state.visibleColumns
+	if len(rows) == 0 || len(cols) == 0 {
+		return -1, -1, false
+	}
+
+	// Nothing selected yet: Tab enters at the first (or last) cell
+	rowPos := indexOf(rows, st.state.selectedRow)
+	if rowPos < 0 {
+		if forward {
+			return rows[0], cols[0], true
+		}
+		return rows[len(rows)-1], cols[len(cols)-1], true
+	}
+
+	colPos := 0
+	if !st.config.RowSelectOnlyMode {
+		colPos = indexOf(cols, st.state.selectedCol)
+		if colPos < 0 {
+			colPos = 0
+		}
+	}
+
+	step := 1
+	if !forward {
+		step = -1
+	}
+
+	if st.config.RowSelectOnlyMode {
+		rowPos += step
+	} else {
+		colPos += step
+		if colPos >= len(cols) {
+			colPos = 0
+			rowPos++
+		} else if colPos < 0 {
+			colPos = len(cols) - 1
+			rowPos--
+		}
+	}
+
+	if rowPos < 0 || rowPos >= len(rows) {
+		return -1, -1, false
+	}
+
+	col = cols[colPos]
+	if st.config.RowSelectOnlyMode && st.state.selectedCol >= 0 {
+		col = st.state.selectedCol
+	}
+	return rows[rowPos], col, true
+}
+
+// refreshWidget refreshes the underlying table widget.
+// It is a variable so tests can count refreshes.
+var refreshWidget = func(t *widget.Table) {
+	t.Refresh()
+}
+
+// refreshTable refreshes the table widget, or defers the refresh to EndUpdate
+// during a batch update
+func (st *Table) refreshTable() {
+	if st.table == nil {
+		return
+	}
+	if st.deferRefresh() {
+		return
+	}
+	st.applyDetailRowHeights()
+	refreshWidget(st.table.Table)
+	st.refreshDetailOverlay()
+}
+
+// deferRefresh records a refresh for EndUpdate and returns true during a batch update
+func (st *Table) deferRefresh() bool {
+	st.listenersMu.Lock()
+	defer st.listenersMu.Unlock()
+	if st.updateDepth > 0 {
+		st.refreshPending = true
+		return true
+	}
+	return false
+}
+
+// refreshWidgetItem re-renders one cell of the underlying table widget.
+// It is a variable so tests can count refreshes.
+var refreshWidgetItem = func(t *widget.Table, id widget.TableCellID) {
+	t.RefreshItem(id)
+}
+
+// refreshCell re-renders a single data cell (data index, column index) instead of the
+// whole table. Cells that are not shown are skipped; during a batch update the refresh
+// is deferred to EndUpdate like refreshTable.
+func (st *Table) refreshCell(dataIndex, colIndex int) {
+	if st.table == nil {
+		return
+	}
+	if st.deferRefresh() {
+		return
+	}
+	row := indexOf(st.state.visibleRows, dataIndex)
+	col := indexOf(st.state.visibleColumns, colIndex)
+	if row < 0 || col < 0 {
+		return
+	}
+	// TableCellID uses display indices: row 0 is the header
+	refreshWidgetItem(st.table.Table, widget.TableCellID{Row: row + 1, Col: col})
+}
+
+// refreshSelectionCells re-renders the cells a selection at (dataIndex, colIndex)
+// highlights: the whole row in RowSelectOnlyMode or with HighlightFullRow, otherwise the cell
+func (st *Table) refreshSelectionCells(dataIndex, colIndex int) {
+	if st.config.RowSelectOnlyMode || st.config.HighlightFullRow {
+		for _, col := range st.state.visibleColumns {
+			st.refreshCell(dataIndex, col)
+		}
+		return
+	}
+	st.refreshCell(dataIndex, colIndex)
+}
+
+// moveSelectionTo selects the cell after an in-place change (edit, checkbox, popup)
+// and re-renders only that cell and the previously selected one, without refreshing
+// the whole table or firing selection callbacks, so arrow-key navigation continues
+// from the new cell
+func (st *Table) moveSelectionTo(dataIndex, colIndex int) {
+	if dataIndex < 0 || dataIndex >= len(st.data) {
+		return
+	}
+	oldRow, oldCol := st.state.selectedRow, st.state.selectedCol
+	st.state.selectedRow = dataIndex
+	st.state.selectedCol = colIndex
+	if oldRow != dataIndex || oldCol != colIndex {
+		st.refreshSelectionCells(oldRow, oldCol)
+	}
+	st.refreshSelectionCells(dataIndex, colIndex)
+}
+
+// BeginUpdate starts a batch of changes (SetData, SetFilter, Sort, selection, ...)
+// during which the table is not refreshed. Each BeginUpdate must be matched by an
+// EndUpdate; pairs may be nested. Call from the UI thread.
+func (st *Table) BeginUpdate() {
+	st.listenersMu.Lock()
+	st.updateDepth++
+	st.listenersMu.Unlock()
+}
+
+// EndUpdate ends a batch started with BeginUpdate. When the outermost batch ends,
+// the table is refreshed once if any change needed it and listeners are notified once
+// of any changes. An EndUpdate without a matching BeginUpdate does nothing.
+func (st *Table) EndUpdate() {
+	st.listenersMu.Lock()
+	if st.updateDepth == 0 {
+		st.listenersMu.Unlock()
+		return
+	}
+	st.updateDepth--
+	refresh := st.updateDepth == 0 && st.refreshPending
+	if refresh {
+		st.refreshPending = false
+	}
+	st.listenersMu.Unlock()
+
+	if refresh {
+		st.refreshTable()
+	}
+	st.flushListeners()
+}
+
+// currentKeyModifiers returns the modifier keys currently held (desktop drivers only).
+// It is a variable so tests can simulate modifier keys.
+var currentKeyModifiers = func() fyne.KeyModifier {
+	app := fyne.CurrentApp()
+	if app == nil {
+		return 0
+	}
+	if drv, ok := app.Driver().(desktop.Driver); ok {
+		return drv.CurrentKeyModifiers()
+	}
+	return 0
+}
+
+// isShiftPressed reports whether Shift is currently held (desktop drivers only)
+func (st *Table) isShiftPressed() bool {
+	return currentKeyModifiers()&fyne.KeyModifierShift != 0
+}
+
+// isToggleSelectPressed reports whether Ctrl (or Cmd on macOS) is currently held
+func (st *Table) isToggleSelectPressed() bool {
+	return currentKeyModifiers()&(fyne.KeyModifierControl|fyne.KeyModifierSuper) != 0
+}
+
+// indexOf returns the position of value in values, or -1 if absent
+func indexOf(values []int, value int) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
 }
 
 // FocusGained implements Focusable interface - delegates to FocusHandler
@@ -379,14 +781,14 @@ func (st *Table) Tapped(*fyne.PointEvent) {
 // handleCellClick handles clicking on a cell - selects row but doesn't start editing
 func (st *Table) handleCellClick(id widget.TableCellID) {
 	if st.MouseHandler != nil {
-		st.MouseHandler.HandleCellClick(id, st)
+		st.notifyInput(func() { st.MouseHandler.HandleCellClick(id, st) })
 	}
 }
 
 // handleHeaderClick handles clicking on a column header to sort
 func (st *Table) handleHeaderClick(colIndex int) {
 	if st.MouseHandler != nil {
-		st.MouseHandler.HandleHeaderClick(colIndex, st)
+		st.notifyInput(func() { st.MouseHandler.HandleHeaderClick(colIndex, st) })
 	}
 }
 
@@ -413,9 +815,12 @@ func (st *Table) RebuildVisibleColumns() {
 
 // SetColumnVisibility sets whether a column is visible or hidden
 func (st *Table) SetColumnVisibility(columnID string, visible bool) {
+	defer st.deferNotify()() // Moving the selection off a hidden column notifies too
+
 	// Find the column by ID
 	for i := range st.config.Columns {
 		if st.config.Columns[i].ID == columnID {
+			changed := st.config.Columns[i].Hidden == visible
 			st.config.Columns[i].Hidden = !visible
 			st.RebuildVisibleColumns()
 
@@ -445,7 +850,7 @@ func (st *Table) SetColumnVisibility(columnID string, visible bool) {
 					}
 
 					st.state.selectedCol = nextCol
-					st.logger().Info(fmt.Sprintf("Selected column was hidden, moved to next visible col %d", st.state.selectedCol))
+					st.logger().Debug("Selected column was hidden, moved to next visible column", "col", st.state.selectedCol)
 
 					// Update the visual selection in Fyne's table
 					if st.state.selectedRow >= 0 && st.table != nil {
@@ -465,19 +870,82 @@ func (st *Table) SetColumnVisibility(columnID string, visible bool) {
 					}
 				}
 				// Refresh the table to apply changes
-				st.table.Refresh()
+				st.refreshTable()
+			}
+
+			// Notify after the visible columns are rebuilt so handlers see the new state
+			if changed && st.config.OnColumnVisibilityChanged != nil {
+				st.config.OnColumnVisibilityChanged(columnID, visible)
+			}
+			if changed {
+				st.notifyListeners()
 			}
 			return
 		}
 	}
 }
 
+// SetColumnOrder reorders the columns. order must list every column ID exactly once.
+// Sorting, selection and column filters follow their columns; an edit in progress is
+// cancelled. Config.OnColumnOrderChanged is called with the new order.
+func (st *Table) SetColumnOrder(order []string) error {
+	if len(order) != len(st.config.Columns) {
+		return &TableError{Op: "set column order", Err: fmt.Errorf("got %d column IDs, want %d", len(order), len(st.config.Columns))}
+	}
+	newIndex := make(map[int]int, len(order)) // Old column index -> new index
+	columns := make([]ColumnConfig, len(order))
+	for i, id := range order {
+		oldIndex := st.columnIndexByID(id)
+		if oldIndex < 0 {
+			return &TableError{Op: "set column order", Err: fmt.Errorf("unknown column %q", id)}
+		}
+		if _, dup := newIndex[oldIndex]; dup {
+			return &TableError{Op: "set column order", Err: fmt.Errorf("column %q listed twice", id)}
+		}
+		newIndex[oldIndex] = i
+		columns[i] = st.config.Columns[oldIndex]
+	}
+
+	if st.state.IsEditing() {
+		st.cancelEdit()
+	}
+
+	st.mu.Lock()
+	st.config.Columns = columns
+	if st.state.sortColumn >= 0 {
+		st.state.sortColumn = newIndex[st.state.sortColumn]
+	}
+	for i, key := range st.state.thenSortBy {
+		st.state.thenSortBy[i].column = newIndex[key.column]
+	}
+	if st.state.selectedCol >= 0 {
+		st.state.selectedCol = newIndex[st.state.selectedCol]
+	}
+	st.RebuildVisibleColumns()
+	st.mu.Unlock()
+
+	if st.table != nil {
+		for displayIdx, actualIdx := range st.state.visibleColumns {
+			if width := st.config.Columns[actualIdx].Width; width > 0 {
+				st.table.SetColumnWidth(displayIdx, width)
+			}
+		}
+		st.refreshTable()
+	}
+
+	if st.config.OnColumnOrderChanged != nil {
+		st.config.OnColumnOrderChanged(slices.Clone(order))
+	}
+	st.notifyListeners()
+	return nil
+}
+
 // SetColumnReadOnly sets whether a column is read-only (non-activatable)
 func (st *Table) SetColumnReadOnly(columnID string, readOnly bool) {
 	for i := range st.config.Columns {
 		if st.config.Columns[i].ID == columnID {
 			st.config.Columns[i].ReadOnly = readOnly
-			st.logger().Info(fmt.Sprintf("Column %s ReadOnly set to %t", columnID, readOnly))
+			st.logger().Debug("Column ReadOnly set", "column", columnID, "readOnly", readOnly)
 			return
 		}
 	}
@@ -490,72 +958,145 @@ func (st *Table) SetRowSelectOnlyMode(rowOnlyMode bool) {
 	// If switching to row-column mode, ensure selectedCol is initialized
 	if !rowOnlyMode && st.state.selectedCol < 0 && len(st.state.visibleColumns) > 0 {
 		st.state.selectedCol = st.state.visibleColumns[0]
-		st.logger().Info(fmt.Sprintf("Switched to row-column mode, initialized selectedCol to %d", st.state.selectedCol))
+		st.logger().Debug("Switched to row-column mode", "selectedCol", st.state.selectedCol)
 	}
 
 	// Refresh to update highlighting
 	if st.table != nil {
-		st.table.Refresh()
+		st.refreshTable()
+	}
+}
+
+// SetDensity applies a Density preset and refreshes. Non-zero Config.RowHeight,
+// HeaderHeight and FontSize still override the preset.
+func (st *Table) SetDensity(density Density) {
+	st.config.Density = density
+	if st.table == nil {
+		return
+	}
+	if height := st.config.headerHeight(); height > 0 {
+		st.table.SetRowHeight(0, height)
 	}
+	st.refreshTable() // Fyne re-measures the cell template, which sets the row height
 }
 
 // RebuildVisibleRows rebuilds the list of visible row indices based on tree state and filter
 func (st *Table) RebuildVisibleRows() {
-	st.state.visibleRows = make([]int, 0, len(st.data))
+	st.mu.Lock()
+	st.rebuildVisibleRows()
+	st.mu.Unlock()
+
+	st.updateRowCount()
+}
+
+// cancelCheckInterval is how many rows (or comparisons) a cancellable filter or sort
+// processes between checks of its context
+const cancelCheckInterval = 256
+
+// viewOpKind is a kind of cancellable request: filters and sorts only cancel their own
+// kind, so a filter never undoes a sort in flight or the other way round
+type viewOpKind int
+
+const (
+	viewOpFilter viewOpKind = iota // SetFilter
+	viewOpSort                     // Sort, SetSortKeys, ClearSort, header clicks
+	viewOpKinds                    // Number of kinds
+)
+
+// viewOp is the request of a kind in flight
+type viewOp struct {
+	ctx    context.Context // Cancelled when a newer request of the kind starts
+	cancel context.CancelFunc
+}
+
+// beginViewOp starts a filter or sort request, cancelling the one of the same kind in
+// flight so the latest request wins. A cancelled request leaves the rows as they were;
+// the newer request (waiting for st.mu) rebuilds them. Call cancel when the request is
+// done.
+func (st *Table) beginViewOp(kind viewOpKind) (ctx context.Context, cancel context.CancelFunc) {
+	st.viewOpMu.Lock()
+	defer st.viewOpMu.Unlock()
+	if op := st.viewOps[kind]; op.cancel != nil {
+		op.cancel()
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	st.viewOps[kind] = viewOp{ctx: ctx, cancel: cancel}
+	return ctx, cancel
+}
+
+// rebuildVisibleRows does the work of RebuildVisibleRows; the caller must hold st.mu
+func (st *Table) rebuildVisibleRows() {
+	st.rebuildVisibleRowsCtx(context.Background())
+}
+
+// rebuildVisibleRowsCtx rebuilds the visible rows unless ctx is cancelled part way,
+// in which case it returns false and leaves them unchanged. The caller must hold st.mu.
+func (st *Table) rebuildVisibleRowsCtx(ctx context.Context) bool {
+	previousRows := st.state.visibleRows
+	rows := make([]int, 0, len(st.data))
 
 	// Build filter regex if needed
 	var filterRegex *regexp.Regexp
 	if st.state.filterText != "" && st.state.filterRegex {
 		var err error
-		regexPattern := st.state.filterText
-		if !st.state.filterCaseSensitive {
-			regexPattern = "(?i)" + regexPattern // Case-insensitive flag
-		}
-		filterRegex, err = regexp.Compile(regexPattern)
+		filterRegex, err = compileFilterRegex(st.state.filterText, st.state.filterCaseSensitive)
 		if err != nil {
-			st.logger().Error(fmt.Sprintf("Invalid regex filter: %v", err))
+			st.logger().Error("Invalid regex filter", "error", err)
 			filterRegex = nil
 		}
 	}
 
+	// Compile regex column filters once per rebuild
+	columnRegexes := make(map[string]*regexp.Regexp)
+	for colID, f := range st.state.columnFilters {
+		if f.op == FilterRegex {
+			re, err := compileFilterRegex(f.value, st.state.filterCaseSensitive)
+			if err != nil {
+				st.logger().Error("Invalid regex column filter", "column", colID, "error", err)
+				continue
+			}
+			columnRegexes[colID] = re
+		}
+	}
+
+	// Index nodes by ID so collapsed ancestors can be found (tree functions configured only)
+	var treeNodes map[interface{}]interface{}
+	if st.config.GetNodeID != nil && st.config.GetNodeParentID != nil {
+		treeNodes = make(map[interface{}]interface{}, len(st.data))
+		for _, item := range st.data {
+			treeNodes[st.config.GetNodeID(item)] = item
+		}
+	}
+
+	searchColumns := st.searchColumns()
+	terms := st.searchTerms()
+
 	// Iterate through all data and apply filters
 	for i := range st.data {
+		if i%cancelCheckInterval == 0 && ctx.Err() != nil {
+			st.logger().Debug("[FILTER] Rebuild superseded", "scannedRows", i, "totalRows", len(st.data))
+			return false
+		}
+
 		// Apply text filter if configured
-		if st.state.filterText != "" && len(st.config.FilterColumns) > 0 {
-			matched := false
-			for _, colID := range st.config.FilterColumns {
-				fieldValue := st.extractFieldValue(st.data[i], colID)
-
-				if st.state.filterRegex && filterRegex != nil {
-					// Regex matching
-					if filterRegex.MatchString(fieldValue) {
-						matched = true
-						break
-					}
-				} else {
-					// Plain text substring matching
-					if st.state.filterCaseSensitive {
-						// Case-sensitive
-						if strings.Contains(fieldValue, st.state.filterText) {
-							matched = true
-							break
-						}
-					} else {
-						// Case-insensitive
-						if strings.Contains(strings.ToLower(fieldValue), strings.ToLower(st.state.filterText)) {
-							matched = true
-							break
-						}
-					}
-				}
-			}
-			if !matched {
-				continue // Skip this row
-			}
+		if st.state.filterText != "" && len(searchColumns) > 0 && !st.matchesSearch(st.data[i], searchColumns, filterRegex, terms) {
+			continue // Skip this row
+		}
+
+		// Apply per-column filters: every column filter must match
+		if !st.matchesColumnFilters(st.data[i], columnRegexes) {
+			continue
 		}
 
-		// TODO: Tree expansion/collapse filtering would go here when enabled
-		// For now, include all rows that passed the text filter
+		// Apply custom row predicate
+		if st.state.rowFilter != nil && !st.state.rowFilter(st.data[i]) {
+			continue
+		}
+
+		// Apply tree expansion filter: hide rows under a collapsed ancestor
+		if treeNodes != nil && st.hasCollapsedAncestor(st.data[i], treeNodes) {
+			continue
+		}
 
 		// Apply tree depth filter if MaxDepth is set
 		if st.config.MaxDepth > 0 && st.config.GetNodeDepth != nil {
@@ -565,180 +1106,1125 @@ func (st *Table) RebuildVisibleRows() {
 			}
 		}
 
-		st.state.visibleRows = append(st.state.visibleRows, i)
-	}
-}
-
-// ToggleNodeExpansion toggles the expansion state of a node
-func (st *Table) ToggleNodeExpansion(rowIndex int) {
-	if rowIndex < 0 || rowIndex >= len(st.data) {
-		return
+		rows = append(rows, i)
 	}
+	st.state.visibleRows = rows
+	st.searchHighlight = st.searchHighlightRegex(filterRegex, terms)
 
-	if st.config.GetNodeID == nil {
-		return // Tree functions not configured
+	// Insert group header rows when grouping is enabled
+	st.state.groups = nil
+	if st.config.GroupByColumn != "" {
+		st.applyGrouping()
 	}
 
-	item := st.data[rowIndex]
-	nodeID := st.config.GetNodeID(item)
-
-	// Toggle expansion state
-	st.config.ExpandedNodes[nodeID] = !st.config.ExpandedNodes[nodeID]
-
-	// Rebuild visible rows and refresh
-	st.RebuildVisibleRows()
-	st.createTable()
-	st.Refresh()
+	st.reconcileSelection(previousRows)
+	return true
 }
 
-// SetMaxDepth sets the maximum tree depth to display
-func (st *Table) SetMaxDepth(maxDepth int) {
-	st.config.MaxDepth = maxDepth
-	st.RebuildVisibleRows()
-	if st.table != nil {
-		// Refresh base table to ensure it detects row count changes
-		// Use DoAndWait to ensure refresh runs on UI thread
-		fyne.DoAndWait(func() {
-			st.table.Refresh()
-			st.table.Refresh()
-		})
+// searchTerms returns the plain-text search terms, lowercased unless the search is
+// case-sensitive: the whole filter text with FilterPhrase and FilterFuzzy (or as a
+// fallback for an invalid regex), otherwise its whitespace-separated terms.
+// Caller must hold st.mu.
+func (st *Table) searchTerms() []string {
+	text := st.state.filterText
+	if !st.state.filterCaseSensitive {
+		text = strings.ToLower(text)
+	}
+	switch {
+	case st.state.filterRegex, st.config.FilterMode == FilterPhrase, st.config.FilterMode == FilterFuzzy:
+		return []string{text}
+	default:
+		return splitFilterTerms(text)
 	}
-	st.logger().Info(fmt.Sprintf("SetMaxDepth: %d, visible rows=%d/%d", maxDepth, len(st.state.visibleRows), len(st.data)))
 }
 
-// RequestFocus requests keyboard focus - delegates to FocusHandler
-func (st *Table) RequestFocus() {
-	if st.FocusHandler != nil {
-		st.FocusHandler.RequestFocus(st)
+// splitFilterTerms splits search text on whitespace, keeping "quoted phrases" together
+// (without the quotes). An unclosed quote runs to the end of the text.
+func splitFilterTerms(text string) []string {
+	var terms []string
+	for text != "" {
+		text = strings.TrimLeftFunc(text, unicode.IsSpace)
+		var term string
+		if rest, quoted := strings.CutPrefix(text, `"`); quoted {
+			term, text, _ = strings.Cut(rest, `"`)
+		} else if end := strings.IndexFunc(text, unicode.IsSpace); end >= 0 {
+			term, text = text[:end], text[end:]
+		} else {
+			term, text = text, ""
+		}
+		if term != "" {
+			terms = append(terms, term)
+		}
 	}
+	return terms
 }
 
-// GetSelectedCell returns the currently selected row and column (-1 if none selected)
-func (st *Table) GetSelectedCell() (row int, col int) {
-	return st.state.selectedRow, st.state.selectedCol
-}
+// matchesSearch reports whether item matches the search: filterRegex if set, otherwise
+// terms (from searchTerms) combined according to Config.FilterMode. Each term may match
+// a different column. Caller must hold st.mu.
+func (st *Table) matchesSearch(item interface{}, columns []string, filterRegex *regexp.Regexp, terms []string) bool {
+	values := make([]string, len(columns))
+	for i, colID := range columns {
+		values[i] = st.extractFieldValue(item, colID)
+		if filterRegex == nil && !st.state.filterCaseSensitive {
+			values[i] = strings.ToLower(values[i])
+		}
+	}
 
-// GetEditingState returns true if the table is currently in inline editing mode
-func (st *Table) GetEditingState() bool {
-	return st.state.editingRow >= 0 && st.state.editingCol >= 0
+	if filterRegex != nil {
+		return slices.ContainsFunc(values, filterRegex.MatchString)
+	}
+	if st.config.FilterMode == FilterFuzzy && !st.state.filterRegex {
+		return slices.ContainsFunc(values, func(value string) bool {
+			return fuzzyScore(terms[0], value) >= fuzzyMatchThreshold
+		})
+	}
+	anyTerm := st.config.FilterMode == FilterOr
+	for _, term := range terms {
+		found := slices.ContainsFunc(values, func(value string) bool { return strings.Contains(value, term) })
+		if found && anyTerm {
+			return true
+		}
+		if !found && !anyTerm {
+			return false
+		}
+	}
+	return !anyTerm || len(terms) == 0
 }
 
-// SetSelectedCell programmatically selects a cell and triggers visual update
-func (st *Table) SetSelectedCell(row int, col int) {
-	if row < 0 || row >= len(st.data) {
-		st.logger().Error(fmt.Sprintf("SetSelectedCell: invalid row %d (data has %d rows)", row, len(st.data)))
-		return
+// searchHighlightRegex returns a regex matching what the search matches in a cell, for
+// Config.HighlightMatches: the search regex itself, or any of the plain-text terms
+// (longest first). nil when there is no search, or with FilterFuzzy, whose matches are
+// scattered characters. Caller must hold st.mu.
+func (st *Table) searchHighlightRegex(filterRegex *regexp.Regexp, terms []string) *regexp.Regexp {
+	switch {
+	case st.state.filterText == "":
+		return nil
+	case filterRegex != nil:
+		return filterRegex
+	case st.config.FilterMode == FilterFuzzy && !st.state.filterRegex:
+		return nil
 	}
 
-	st.state.selectedRow = row
-	st.state.selectedCol = col
-
-	// Refresh to update highlighting
-	if st.table != nil {
-		st.table.Refresh()
+	terms = slices.DeleteFunc(slices.Clone(terms), func(term string) bool { return term == "" })
+	if len(terms) == 0 {
+		return nil
+	}
+	slices.SortStableFunc(terms, func(a, b string) int { return len(b) - len(a) })
+	for i, term := range terms {
+		terms[i] = regexp.QuoteMeta(term)
 	}
+	re, err := compileFilterRegex(strings.Join(terms, "|"), st.state.filterCaseSensitive)
+	if err != nil {
+		return nil
+	}
+	return re
 }
 
-// ========== Multi-Select API ==========
+// fuzzyMatchThreshold is the lowest fuzzyScore FilterFuzzy accepts
+const fuzzyMatchThreshold = 0.4
+
+// fuzzyScore scores how well query matches text as a subsequence, from 0 (its characters
+// don't all appear in order) to 1 (a substring at the start of a word). Compact matches,
+// and matches whose characters start words, score higher: "prjalpha" scores 0.71
+// against "project alpha" and "pa" 0.4.
+func fuzzyScore(query, text string) float64 {
+	q, t := []rune(query), []rune(text)
+	if len(q) == 0 {
+		return 1
+	}
+
+	best := 0.0
+	for start := range t {
+		if t[start] != q[0] {
+			continue
+		}
+		// Match the rest greedily from here; the shortest span scores best
+		matched, wordStarts, end := 0, 0, start
+		for i := start; i < len(t) && matched < len(q); i++ {
+			if t[i] == q[matched] {
+				if i == 0 || !unicode.IsLetter(t[i-1]) && !unicode.IsDigit(t[i-1]) {
+					wordStarts++
+				}
+				matched++
+				end = i
+			}
+		}
+		if matched < len(q) {
+			break // Later starts can't match either
+		}
+		span := end - start + 1
+		best = max(best, min(1, float64(len(q)+wordStarts)/float64(span+1)))
+	}
+	return best
+}
+
+// navigableRowSet returns navigableRows as a set, for membership checks of many rows.
+// Caller must hold st.mu.
+func (st *Table) navigableRowSet() map[int]bool {
+	shown := make(map[int]bool, len(st.state.visibleRows))
+	for _, row := range st.navigableRows() {
+		shown[row] = true
+	}
+	return shown
+}
+
+// reconcileSelection drops selected rows that are no longer shown, so the selection
+// never points at a hidden row. With Config.SelectionFollowsFilter a hidden single
+// selection moves to the nearest shown row (in the previous display order) instead.
+// Caller must hold st.mu.
+func (st *Table) reconcileSelection(previousRows []int) {
+	shown := st.navigableRowSet()
+
+	if len(st.state.selectedRows) > 0 {
+		for row := range st.state.selectedRows {
+			if !shown[row] {
+				delete(st.state.selectedRows, row)
+			}
+		}
+		return
+	}
+
+	row := st.state.selectedRow
+	if row < 0 || shown[row] {
+		return
+	}
+	st.state.selectedRow = -1
+	if !st.config.SelectionFollowsFilter {
+		return
+	}
+
+	// Walk outwards from the row's previous position: following rows first, then preceding
+	pos := indexOf(previousRows, row)
+	if pos < 0 {
+		if rows := st.selectableRows(); len(rows) > 0 {
+			st.state.selectedRow = rows[0]
+		}
+		return
+	}
+	for dist := 1; dist < len(previousRows); dist++ {
+		for _, i := range []int{pos + dist, pos - dist} {
+			if i >= 0 && i < len(previousRows) && shown[previousRows[i]] && st.isRowSelectable(previousRows[i]) {
+				st.state.selectedRow = previousRows[i]
+				return
+			}
+		}
+	}
+	if rows := st.selectableRows(); len(rows) > 0 {
+		st.state.selectedRow = rows[0] // No previously shown row is still shown
+	}
+}
+
+// IsSelectionVisible reports whether something is selected and every selected row
+// is currently shown (not filtered out, collapsed or grouped away)
+func (st *Table) IsSelectionVisible() bool {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	selected := st.state.GetSelectedRows()
+	if len(selected) == 0 {
+		return false
+	}
+	shown := st.navigableRowSet()
+	for _, row := range selected {
+		if !shown[row] {
+			return false
+		}
+	}
+	return true
+}
+
+// groupHeaderRow encodes group index g as a synthetic entry in visibleRows.
+// Group headers use values below -1 so they never collide with data indices or "none".
+func groupHeaderRow(g int) int {
+	return -2 - g
+}
+
+// groupIndexOf decodes a visibleRows entry; ok is false for data rows
+func groupIndexOf(row int) (g int, ok bool) {
+	if row <= -2 {
+		return -2 - row, true
+	}
+	return -1, false
+}
+
+// applyGrouping groups visibleRows by the GroupByColumn value (in order of first
+// appearance) and inserts a header row before each group. Rows of collapsed groups are omitted.
+func (st *Table) applyGrouping() {
+	groupIndex := make(map[string]int)
+	for _, row := range st.state.visibleRows {
+		value := st.extractFieldValue(st.data[row], st.config.GroupByColumn)
+		g, ok := groupIndex[value]
+		if !ok {
+			g = len(st.state.groups)
+			groupIndex[value] = g
+			st.state.groups = append(st.state.groups, rowGroup{value: value})
+		}
+		st.state.groups[g].rows = append(st.state.groups[g].rows, row)
+	}
+
+	rows := make([]int, 0, len(st.state.visibleRows)+len(st.state.groups))
+	for g, group := range st.state.groups {
+		rows = append(rows, groupHeaderRow(g))
+		if !st.state.collapsedGroups[group.value] {
+			rows = append(rows, group.rows...)
+		}
+	}
+	st.state.visibleRows = rows
+}
+
+// navigableRows returns the visible data rows, skipping group header rows
+func (st *Table) navigableRows() []int {
+	if len(st.state.groups) == 0 {
+		return st.state.visibleRows
+	}
+	rows := make([]int, 0, len(st.state.visibleRows))
+	for _, row := range st.state.visibleRows {
+		if _, ok := groupIndexOf(row); !ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// isRowSelectable reports whether the data row can be selected (see Config.IsRowSelectable
+// and Config.FullWidthRowsSelectable)
+func (st *Table) isRowSelectable(dataIndex int) bool {
+	if st.config.IsRowSelectable == nil && (st.config.FullWidthRowRenderer == nil || st.config.FullWidthRowsSelectable) {
+		return true
+	}
+	if dataIndex < 0 || dataIndex >= len(st.data) {
+		return false
+	}
+	return st.isItemSelectable(dataIndex, st.data[dataIndex])
+}
+
+// isItemSelectable is isRowSelectable for the data row's item, without reading st.data
+func (st *Table) isItemSelectable(dataIndex int, item interface{}) bool {
+	if !st.config.FullWidthRowsSelectable && st.config.FullWidthRowRenderer != nil && st.config.FullWidthRowRenderer(dataIndex, item) != nil {
+		return false
+	}
+	return st.config.IsRowSelectable == nil || st.config.IsRowSelectable(item)
+}
+
+// isFullWidthRow reports whether Config.FullWidthRowRenderer replaces the data row's cells
+func (st *Table) isFullWidthRow(dataIndex int) bool {
+	if st.config.FullWidthRowRenderer == nil || dataIndex < 0 || dataIndex >= len(st.data) {
+		return false
+	}
+	return st.config.FullWidthRowRenderer(dataIndex, st.data[dataIndex]) != nil
+}
+
+// selectableRows returns the navigable rows that can be selected, in display order
+func (st *Table) selectableRows() []int {
+	rows := st.navigableRows()
+	if st.config.IsRowSelectable == nil && (st.config.FullWidthRowRenderer == nil || st.config.FullWidthRowsSelectable) {
+		return rows
+	}
+	selectable := make([]int, 0, len(rows))
+	for _, row := range rows {
+		if st.isRowSelectable(row) {
+			selectable = append(selectable, row)
+		}
+	}
+	return selectable
+}
+
+// SetGroupBy groups rows by the given column's value (empty = no grouping)
+func (st *Table) SetGroupBy(columnID string) {
+	st.config.GroupByColumn = columnID
+	st.RebuildVisibleRows()
+	if st.table != nil {
+		st.refreshTable()
+	}
+	st.logger().Debug("[GROUP] Group by set", "column", columnID, "groups", len(st.state.groups), "visibleRows", len(st.state.visibleRows))
+}
+
+// ToggleGroupCollapsed collapses or expands the group with the given value
+func (st *Table) ToggleGroupCollapsed(groupValue string) {
+	if st.state.collapsedGroups == nil {
+		st.state.collapsedGroups = make(map[string]bool)
+	}
+	st.state.collapsedGroups[groupValue] = !st.state.collapsedGroups[groupValue]
+
+	st.RebuildVisibleRows()
+	if st.table != nil {
+		st.refreshTable()
+	}
+}
+
+// IsGroupCollapsed reports whether the group with the given value is collapsed
+func (st *Table) IsGroupCollapsed(groupValue string) bool {
+	return st.state.collapsedGroups[groupValue]
+}
+
+// groupHeaderText builds the header label: toggle icon, value, row count and optional aggregate
+func (st *Table) groupHeaderText(group rowGroup) string {
+	icon := "▼"
+	if st.state.collapsedGroups[group.value] {
+		icon = "▶"
+	}
+	text := fmt.Sprintf("%s %s (%d)", icon, group.value, len(group.rows))
+
+	if st.config.GroupAggregateFunc != nil {
+		items := make([]interface{}, len(group.rows))
+		for i, row := range group.rows {
+			items[i] = st.data[row]
+		}
+		if summary := st.config.GroupAggregateFunc(group.value, items); summary != "" {
+			text += " — " + summary
+		}
+	}
+	return text
+}
+
+// matchesColumnFilters reports whether an item satisfies all per-column filters
+func (st *Table) matchesColumnFilters(item interface{}, regexes map[string]*regexp.Regexp) bool {
+	for colID, f := range st.state.columnFilters {
+		fieldValue := st.extractFieldValue(item, colID)
+
+		if f.op == FilterRegex {
+			re := regexes[colID]
+			if re != nil && !re.MatchString(fieldValue) {
+				return false
+			}
+			continue
+		}
+
+		value := f.value
+		if !st.state.filterCaseSensitive {
+			fieldValue = strings.ToLower(fieldValue)
+			value = strings.ToLower(value)
+		}
+
+		var matched bool
+		switch f.op {
+		case FilterEquals:
+			matched = fieldValue == value
+		case FilterStartsWith:
+			matched = strings.HasPrefix(fieldValue, value)
+		case FilterEndsWith:
+			matched = strings.HasSuffix(fieldValue, value)
+		default:
+			matched = strings.Contains(fieldValue, value)
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// ToggleNodeExpansion toggles the expansion state of a node
+func (st *Table) ToggleNodeExpansion(rowIndex int) {
+	if rowIndex < 0 || rowIndex >= len(st.data) {
+		return
+	}
+
+	if st.config.GetNodeID == nil {
+		return // Tree functions not configured
+	}
+
+	item := st.data[rowIndex]
+	nodeID := st.config.GetNodeID(item)
+
+	// Toggle expansion state
+	expanded := st.isNodeExpanded(nodeID)
+	if st.config.ExpandedNodes == nil {
+		st.config.ExpandedNodes = make(map[interface{}]bool)
+	}
+	st.config.ExpandedNodes[nodeID] = !expanded
+
+	// Rebuild visible rows and refresh the existing table (it is already in the renderer)
+	st.RebuildVisibleRows()
+	if st.table != nil {
+		st.refreshTable()
+	}
+}
+
+// isNodeExpanded reports whether a node is expanded.
+// Nodes are expanded unless ExpandedNodes explicitly marks them false.
+func (st *Table) isNodeExpanded(nodeID interface{}) bool {
+	expanded, ok := st.config.ExpandedNodes[nodeID]
+	return !ok || expanded
+}
+
+// hasCollapsedAncestor walks the parent chain of item and reports whether any ancestor is collapsed
+func (st *Table) hasCollapsedAncestor(item interface{}, nodes map[interface{}]interface{}) bool {
+	parentID := st.config.GetNodeParentID(item)
+	// Bound the walk by the node count to guard against cyclic parent IDs
+	for steps := 0; parentID != nil && steps <= len(nodes); steps++ {
+		if !st.isNodeExpanded(parentID) {
+			return true
+		}
+		parent, ok := nodes[parentID]
+		if !ok {
+			return false
+		}
+		parentID = st.config.GetNodeParentID(parent)
+	}
+	return false
+}
+
+// SetMaxDepth sets the maximum tree depth to display
+func (st *Table) SetMaxDepth(maxDepth int) {
+	st.config.MaxDepth = maxDepth
+	st.RebuildVisibleRows()
+	if st.table != nil {
+		// Refresh base table to ensure it detects row count changes
+		// Use DoAndWait to ensure refresh runs on UI thread
+		fyne.DoAndWait(func() {
+			st.refreshTable()
+			st.refreshTable()
+		})
+	}
+	st.logger().Debug("SetMaxDepth", "maxDepth", maxDepth, "visibleRows", len(st.state.visibleRows), "totalRows", len(st.data))
+}
+
+// RequestFocus requests keyboard focus - delegates to FocusHandler
+func (st *Table) RequestFocus() {
+	if st.FocusHandler != nil {
+		st.FocusHandler.RequestFocus(st)
+	}
+}
+
+// GetSelectedCell returns the currently selected row and column (-1 if none selected)
+func (st *Table) GetSelectedCell() (row int, col int) {
+	return st.state.selectedRow, st.state.selectedCol
+}
+
+// GetEditingState returns true if the table is currently in inline editing mode
+func (st *Table) GetEditingState() bool {
+	return st.state.editingRow >= 0 && st.state.editingCol >= 0
+}
+
+// SetSelectedCell programmatically selects a cell and triggers visual update
+func (st *Table) SetSelectedCell(row int, col int) {
+	if row < 0 || row >= len(st.data) {
+		st.logger().Error("SetSelectedCell: invalid row", "row", row, "totalRows", len(st.data))
+		return
+	}
+
+	st.state.selectedRow = row
+	st.state.selectedCol = col
+
+	// Refresh to update highlighting
+	if st.table != nil {
+		st.refreshTable()
+	}
+	st.notifyListeners()
+}
+
+// GetSortState returns the ID and direction of the column the table is sorted by
+// (the primary key of a multi-column sort, see GetSortKeys).
+// sorted is false (and columnID empty) when no sort is active.
+func (st *Table) GetSortState() (columnID string, ascending bool, sorted bool) {
+	if st.state.sortColumn < 0 || st.state.sortColumn >= len(st.config.Columns) {
+		return "", true, false
+	}
+	return st.config.Columns[st.state.sortColumn].ID, st.state.sortAsc, true
+}
+
+// Sort sorts the table by the column with the given ID, updates the header
+// indicator and refreshes. Returns an error, leaving the data unchanged, if the
+// column does not exist or is not sortable. A newer sort (Sort, SetSortKeys or a
+// header click) cancels one still in progress, which then returns nil without
+// changing the order; filters don't.
+func (st *Table) Sort(columnID string, ascending bool) error {
+	colIndex := st.columnIndexByID(columnID)
+	if colIndex < 0 {
+		return &TableError{Op: "sort", Err: fmt.Errorf("unknown column %q", columnID)}
+	}
+	if !st.config.Columns[colIndex].Sortable {
+		return &TableError{Op: "sort", Err: fmt.Errorf("column %q is not sortable", columnID)}
+	}
+	st.sortBy([]columnSort{{column: colIndex, asc: ascending}})
+	return nil
+}
+
+// SetSortKeys sorts the table by several columns in priority order: rows that are
+// equal in the first key's column are ordered by the second key, and so on. Keys for
+// unknown or unsortable columns, and repeats of a column, are skipped; no valid keys
+// clears the sort. Like Sort, a newer sort cancels one in progress.
+//
+//	table.SetSortKeys([]table.SortKey{
+//		{ColumnID: "status", Ascending: true},
+//		{ColumnID: "priority", Ascending: false},
+//	})
+func (st *Table) SetSortKeys(keys []SortKey) {
+	var sortKeys []columnSort
+	for _, key := range keys {
+		colIndex := st.columnIndexByID(key.ColumnID)
+		if colIndex < 0 || !st.config.Columns[colIndex].Sortable || sortKeyIndex(sortKeys, colIndex) >= 0 {
+			st.logger().Debug("[SORT] Skipping sort key", "column", key.ColumnID)
+			continue
+		}
+		sortKeys = append(sortKeys, columnSort{column: colIndex, asc: key.Ascending})
+	}
+	st.sortBy(sortKeys)
+}
+
+// ClearSort removes the sort, restoring the order the data was set in, and clears the
+// header indicators
+func (st *Table) ClearSort() {
+	st.sortBy(nil)
+}
+
+// GetSortKeys returns the sort keys in priority order, or nil when not sorted
+func (st *Table) GetSortKeys() SortSpec {
+	var spec SortSpec
+	for _, key := range st.state.sortKeys() {
+		if key.column < len(st.config.Columns) {
+			spec = append(spec, SortKey{ColumnID: st.config.Columns[key.column].ID, Ascending: key.asc})
+		}
+	}
+	return spec
+}
+
+// sortBy sorts the table by keys (clearing the sort if empty), refreshes and notifies
+func (st *Table) sortBy(keys []columnSort) {
+	st.updateSort(func([]columnSort) []columnSort { return keys })
+}
+
+// updateSort sorts the table by the keys nextKeys derives from the current ones
+// (clearing the sort if empty), refreshes and notifies. nextKeys runs under the
+// table lock, once any sort it supersedes has stopped.
+func (st *Table) updateSort(nextKeys func(current []columnSort) []columnSort) {
+	// Sorting reorders rows, so an in-progress edit would be saved to the wrong row
+	if st.state.IsEditing() {
+		st.cancelEdit()
+	}
+
+	ctx, cancel := st.beginViewOp(viewOpSort)
+	defer cancel()
+
+	st.mu.Lock()
+	previousKeys := st.state.sortKeys()
+	keys := nextKeys(slices.Clone(previousKeys))
+	sorted := true
+	st.reorderKeepingSelection(func() {
+		if len(keys) == 0 {
+			st.clearSort()
+			return
+		}
+		st.state.setSortKeys(keys)
+		sorted = st.sortDataCtx(ctx)
+	})
+	if !sorted {
+		// Superseded by a newer sort: the data keeps its previous order
+		st.state.setSortKeys(previousKeys)
+		st.mu.Unlock()
+		return
+	}
+	st.rebuildVisibleRows() // Row order changed
+	st.mu.Unlock()
+
+	if st.table != nil {
+		st.refreshTable() // Re-renders rows and the header sort indicators
+	}
+	st.sortChanged()
+	st.notifyListeners()
+}
+
+// sortChanged reports the current sort to Config.OnSortChanged
+func (st *Table) sortChanged() {
+	if st.config.OnSortChanged != nil {
+		columnID, ascending, _ := st.GetSortState()
+		st.config.OnSortChanged(columnID, ascending)
+	}
+}
+
+// sortKeyIndex returns the position of the key for column colIndex in keys, or -1
+func sortKeyIndex(keys []columnSort, colIndex int) int {
+	return slices.IndexFunc(keys, func(key columnSort) bool { return key.column == colIndex })
+}
+
+// columnIndexByID returns the index of the column with the given ID, or -1 if none
+func (st *Table) columnIndexByID(columnID string) int {
+	for i := range st.config.Columns {
+		if st.config.Columns[i].ID == columnID {
+			return i
+		}
+	}
+	return -1
+}
+
+// ========== Multi-Select API ==========
 
 // GetSelectedRows returns a slice of selected row indices (works for both single and multi-select)
 func (st *Table) GetSelectedRows() []int {
 	return st.state.GetSelectedRows()
 }
 
-// SetSelectedRows sets multiple rows as selected (enables multi-select mode)
-func (st *Table) SetSelectedRows(rows []int) {
-	st.state.SetSelectedRows(rows)
+// SetSelectedRows sets multiple rows as selected (enables multi-select mode)
+func (st *Table) SetSelectedRows(rows []int) {
+	st.state.SetSelectedRows(rows)
+
+	// Refresh to update highlighting
+	if st.table != nil {
+		st.refreshTable()
+	}
+	st.notifyListeners()
+}
+
+// ClearSelection clears all selected rows
+func (st *Table) ClearSelection() {
+	st.state.ClearSelection()
+
+	// Refresh to update highlighting
+	if st.table != nil {
+		st.refreshTable()
+	}
+	st.notifyListeners()
+}
+
+// SelectAllVisible selects every row currently shown, i.e. passing the filters and not
+// in a collapsed group or tree node. Disabled rows and group headers are skipped.
+func (st *Table) SelectAllVisible() {
+	st.state.SetSelectedRows(st.selectableRows())
+
+	// Refresh to update highlighting
+	if st.table != nil {
+		st.refreshTable()
+	}
+	st.notifyListeners()
+}
+
+// DeselectAll clears all selected rows, the same as ClearSelection
+func (st *Table) DeselectAll() {
+	st.ClearSelection()
+}
+
+// GetSelectionCount returns the number of selected rows
+func (st *Table) GetSelectionCount() int {
+	return st.state.GetSelectionCount()
+}
+
+// ========================================
+
+// SetData updates the table data.
+//
+// When the row count is unchanged or Config.PreserveScrollOnUpdate is set, the update
+// keeps the current scroll position: Config.InitialSelection is not re-applied, and with
+// Config.GetNodeID the selection follows the previously selected rows by ID.
+// With Config.RowKey the selection always follows the selected rows by key.
+func (st *Table) SetData(data []interface{}) {
+	defer st.deferNotify()() // Includes the initial selection
+
+	st.mu.Lock()
+	hadData := len(st.data) > 0
+	preserve := hadData && (st.config.PreserveScrollOnUpdate || len(data) == len(st.data))
+	selectedKeys := st.selectedKeys()
+	st.data = data
+	st.unsortedData = slices.Clone(data) // Sorting reorders data in place
+	st.closeDetails()                    // Details are keyed by data index
+
+	// Drop selection/edit state that points past the new data
+	st.state.ClampToRowCount(len(data))
+	if !st.state.IsEditing() {
+		st.editingEntry = nil
+		st.editingWidget = nil
+	}
+
+	// Re-apply current sort if one is active
+	if st.state.sortColumn >= 0 && st.state.sortColumn < len(st.config.Columns) {
+		st.logger().Debug("[SETDATA] Re-applying sort", "column", st.state.sortColumn, "asc", st.state.sortAsc)
+		st.sortData()
+	}
+
+	if selectedKeys != nil && (preserve || st.config.RowKey != nil) {
+		st.reselectKeys(selectedKeys)
+	}
+
+	st.rebuildVisibleRows() // Update visible rows based on tree state
+	st.mu.Unlock()
+
+	st.updateRowCount()
+
+	if st.table != nil {
+		st.refreshTable()
+	}
+	st.notifyListeners()
+
+	// Apply the initial selection and focus if configured and data exists (re-selecting
+	// on a preserving update would jump away from where the user is)
+	if !preserve && len(data) > 0 {
+		st.applyInitialSelection()
+	}
+}
+
+// applyInitialSelection selects the row chosen by Config.InitialSelection (or
+// SelectFirstCellOnStartup), scrolls it into view and requests focus if configured
+func (st *Table) applyInitialSelection() {
+	mode := st.config.InitialSelection
+	if mode == InitialSelectionNone && st.config.SelectFirstCellOnStartup {
+		mode = InitialSelectionFirstRow
+	}
+
+	st.mu.RLock()
+	row := -1
+	rows := st.selectableRows()
+	switch {
+	case len(rows) == 0:
+	case mode == InitialSelectionFirstRow:
+		row = rows[0]
+	case mode == InitialSelectionLastRow:
+		row = rows[len(rows)-1]
+	case mode == InitialSelectionByKey && st.config.RowKey != nil:
+		for _, r := range rows {
+			if st.config.RowKey(st.data[r]) == st.config.InitialSelectionKey {
+				row = r
+				break
+			}
+		}
+	}
+	displayRow := indexOf(st.state.visibleRows, row)
+	st.mu.RUnlock()
+
+	if row >= 0 && len(st.state.visibleColumns) > 0 {
+		st.SetSelectedCell(row, st.state.visibleColumns[0])
+		if st.table != nil {
+			st.table.ScrollTo(widget.TableCellID{Row: displayRow + 1, Col: 0}) // Row 0 is the header
+		}
+	}
+	if st.config.FocusOnLoad || st.config.SelectFirstCellOnStartup {
+		st.RequestFocus()
+	}
+}
+
+// rowIdentity returns the function identifying a row across data changes:
+// Config.RowKey, falling back to Config.GetNodeID. Returns nil if neither is set.
+func (st *Table) rowIdentity() func(data interface{}) interface{} {
+	if st.config.RowKey != nil {
+		return func(data interface{}) interface{} { return st.config.RowKey(data) }
+	}
+	return st.config.GetNodeID
+}
+
+// selectedKeys returns the identities (see rowIdentity) of the selected rows,
+// or nil if rows have no identity or nothing is selected. Caller must hold st.mu.
+func (st *Table) selectedKeys() []interface{} {
+	identity := st.rowIdentity()
+	if identity == nil {
+		return nil
+	}
+	var keys []interface{}
+	for _, row := range st.state.GetSelectedRows() {
+		if row >= 0 && row < len(st.data) {
+			keys = append(keys, identity(st.data[row]))
+		}
+	}
+	return keys
+}
+
+// reselectKeys moves the selection to the rows whose identities are in keys.
+// Keys no longer present in the data are dropped from the selection.
+// Caller must hold st.mu.
+func (st *Table) reselectKeys(keys []interface{}) {
+	identity := st.rowIdentity()
+	rowsByKey := make(map[interface{}]int, len(st.data))
+	for i, item := range st.data {
+		rowsByKey[identity(item)] = i
+	}
+
+	var rows []int
+	for _, key := range keys {
+		if row, ok := rowsByKey[key]; ok {
+			rows = append(rows, row)
+		}
+	}
+
+	if len(st.state.selectedRows) > 0 {
+		st.state.SetSelectedRows(rows)
+	} else if len(rows) > 0 {
+		st.state.selectedRow = rows[0]
+	} else {
+		st.state.selectedRow = -1
+	}
+}
+
+// reorderKeepingSelection runs reorder (which moves rows to other data indices)
+// and, with Config.RowKey, moves the selection along with its rows.
+// Caller must hold st.mu.
+func (st *Table) reorderKeepingSelection(reorder func()) {
+	if st.config.RowKey == nil {
+		reorder()
+		return
+	}
+	keys := st.selectedKeys()
+	reorder()
+	if keys != nil {
+		st.reselectKeys(keys)
+	}
+}
+
+// SelectByKey selects the row whose Config.RowKey is key, replacing the current
+// selection and keeping the selected column. Returns false if Config.RowKey is
+// not set or no row has the key.
+func (st *Table) SelectByKey(key string) bool {
+	if st.config.RowKey == nil {
+		return false
+	}
+
+	st.mu.RLock()
+	row := -1
+	for i, item := range st.data {
+		if st.config.RowKey(item) == key {
+			row = i
+			break
+		}
+	}
+	st.mu.RUnlock()
+	if row < 0 {
+		return false
+	}
+
+	col := st.state.selectedCol
+	if col < 0 && len(st.state.visibleColumns) > 0 {
+		col = st.state.visibleColumns[0]
+	}
+	st.state.ClearSelection()
+	st.SetSelectedCell(row, col)
+	return true
+}
+
+// GetSelectedKeys returns the Config.RowKey of each selected row, in data order.
+// Returns nil if Config.RowKey is not set.
+func (st *Table) GetSelectedKeys() []string {
+	if st.config.RowKey == nil {
+		return nil
+	}
+
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	keys := []string{}
+	for _, row := range st.state.GetSelectedRows() {
+		if row >= 0 && row < len(st.data) {
+			keys = append(keys, st.config.RowKey(st.data[row]))
+		}
+	}
+	return keys
+}
+
+// SetDataIfChanged is SetData for code that sets the data on every update whether or not
+// it changed: it does nothing if data is the slice already set (with the same length)
+// or Config.DataEqual reports it equal to the current data, saving a re-sort and refresh.
+// Returns whether the data was set. Use SetData after changing rows in place.
+func (st *Table) SetDataIfChanged(data []interface{}) bool {
+	st.mu.RLock()
+	same := len(data) == len(st.data) && (len(data) == 0 || &data[0] == &st.data[0])
+	if !same && st.config.DataEqual != nil {
+		same = st.config.DataEqual(st.unsortedData, data)
+	}
+	st.mu.RUnlock()
+	if same {
+		return false
+	}
+	st.SetData(data)
+	return true
+}
+
+// SetDataAsync replaces the data from any goroutine. The update (including the
+// refresh) is applied on the UI thread.
+func (st *Table) SetDataAsync(data []interface{}) {
+	fyne.Do(func() {
+		st.SetData(data)
+	})
+}
+
+// GetData returns the current data
+func (st *Table) GetData() []interface{} {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return st.data
+}
+
+// ForEachVisibleRow calls fn for each data row currently shown, in display order
+// (sorted, filtered, collapsed nodes and group headers skipped). displayIndex counts
+// the shown data rows from 0; dataIndex is the row's index in GetData(). Iteration
+// stops when fn returns false. fn may call other Table methods.
+func (st *Table) ForEachVisibleRow(fn func(displayIndex, dataIndex int, data interface{}) bool) {
+	st.mu.RLock()
+	rows := slices.Clone(st.navigableRows())
+	items := make([]interface{}, len(rows))
+	for i, row := range rows {
+		items[i] = st.data[row]
+	}
+	st.mu.RUnlock()
+
+	for i, row := range rows {
+		if !fn(i, row, items[i]) {
+			return
+		}
+	}
+}
+
+// VisibleData returns the data items currently shown, in display order. Unlike
+// GetData, it leaves out filtered rows, collapsed nodes and group headers.
+func (st *Table) VisibleData() []interface{} {
+	var items []interface{}
+	st.ForEachVisibleRow(func(_, _ int, data interface{}) bool {
+		items = append(items, data)
+		return true
+	})
+	return items
+}
 
-	// Refresh to update highlighting
+// GetUnderlyingTable returns the base Fyne table widget for direct access
+func (st *Table) GetUnderlyingTable() *widget.Table {
 	if st.table != nil {
-		st.table.Refresh()
+		return st.table.Table
 	}
+	return nil
 }
 
-// ClearSelection clears all selected rows
-func (st *Table) ClearSelection() {
-	st.state.ClearSelection()
+// SetFilter updates the filter text and rebuilds visible rows
+// An invalid regex pattern is rejected: the error is returned (and kept for GetFilterError)
+// while the previous filter and visible rows stay in place. A newer SetFilter
+// cancels a rebuild still scanning the rows, so only the latest filter is applied.
+func (st *Table) SetFilter(filterText string, useRegex bool) error {
+	if useRegex && filterText != "" {
+		if _, err := compileFilterRegex(filterText, st.state.filterCaseSensitive); err != nil {
+			st.state.filterError = &TableError{Op: "filter", Err: err}
+			st.logger().Debug("[FILTER] Rejected invalid regex", "filter", filterText, "error", err)
+			return st.state.filterError
+		}
+	}
 
-	// Refresh to update highlighting
+	ctx, cancel := st.beginViewOp(viewOpFilter)
+	defer cancel()
+
+	st.mu.Lock()
+	st.state.filterError = nil
+	st.state.filterText = filterText
+	st.state.filterRegex = useRegex
+	ok := st.rebuildVisibleRowsCtx(ctx)
+	st.mu.Unlock()
+	if !ok {
+		return nil // Superseded by a newer filter, which rebuilds and refreshes
+	}
+	st.updateRowCount()
 	if st.table != nil {
-		st.table.Refresh()
+		// Refresh base table to ensure it detects row count changes
+		// Use Do (not DoAndWait) to avoid deadlock when called from UI thread
+		fyne.Do(func() {
+			st.refreshTable()
+			st.refreshTable()
+		})
 	}
+	st.logger().Debug("Filter set", "text", filterText, "regex", useRegex, "caseSensitive", st.state.filterCaseSensitive,
+		"visibleRows", len(st.state.visibleRows), "totalRows", len(st.data))
+	st.notifyListeners()
+	return nil
 }
 
-// GetSelectionCount returns the number of selected rows
-func (st *Table) GetSelectionCount() int {
-	return st.state.GetSelectionCount()
+// GetFilterError returns the error from the most recent SetFilter call, or nil if it was accepted
+func (st *Table) GetFilterError() error {
+	return st.state.filterError
 }
 
-// ========================================
-
-// SetData updates the table data
-func (st *Table) SetData(data []interface{}) {
-	st.data = data
+// compileFilterRegex compiles a regex filter pattern, honoring case sensitivity
+func compileFilterRegex(pattern string, caseSensitive bool) (*regexp.Regexp, error) {
+	if !caseSensitive {
+		pattern = "(?i)" + pattern // Case-insensitive flag
+	}
+	return regexp.Compile(pattern)
+}
 
-	// Re-apply current sort if one is active
-	if st.state.sortColumn >= 0 && st.state.sortColumn < len(st.config.Columns) {
-		st.logger().Info(fmt.Sprintf("[SETDATA] Re-applying sort: column=%d asc=%v",
-			st.state.sortColumn, st.state.sortAsc))
-		st.sortData()
+// SetColumnFilterOp filters a single column using the given operator, in addition to the
+// search filter. An empty value removes the column's filter. Case sensitivity follows
+// SetFilterCaseSensitive. An invalid regex is rejected and the current rows stay in place.
+func (st *Table) SetColumnFilterOp(columnID string, op FilterOperator, value string) error {
+	if value == "" {
+		delete(st.state.columnFilters, columnID)
+	} else {
+		if op == FilterRegex {
+			if _, err := compileFilterRegex(value, st.state.filterCaseSensitive); err != nil {
+				return &TableError{Op: "filter", Err: fmt.Errorf("column %s: %w", columnID, err)}
+			}
+		}
+		if st.state.columnFilters == nil {
+			st.state.columnFilters = make(map[string]columnFilter)
+		}
+		st.state.columnFilters[columnID] = columnFilter{op: op, value: value}
 	}
 
-	st.RebuildVisibleRows() // Update visible rows based on tree state
+	st.RebuildVisibleRows()
 	if st.table != nil {
-		st.table.Refresh()
+		// Use Do (not DoAndWait) to avoid deadlock when called from UI thread
+		fyne.Do(func() {
+			st.refreshTable()
+		})
 	}
+	st.logger().Debug("[FILTER] Column filter set", "column", columnID, "op", op, "value", value,
+		"visibleRows", len(st.state.visibleRows), "totalRows", len(st.data))
+	st.notifyListeners()
+	return nil
+}
 
-	// Auto-select first cell if configured and data exists
-	if st.config.SelectFirstCellOnStartup && len(data) > 0 && len(st.state.visibleColumns) > 0 {
-		st.SetSelectedCell(0, st.state.visibleColumns[0])
-		st.RequestFocus()
-	}
+// SetColumnFilter shows only rows whose columnID value contains term, in addition to
+// the search filter and the other column filters. An empty term removes the column's
+// filter. It is shorthand for SetColumnFilterOp with FilterContains.
+func (st *Table) SetColumnFilter(columnID, term string) {
+	_ = st.SetColumnFilterOp(columnID, FilterContains, term) // Contains can't fail
 }
 
-// GetData returns the current data
-func (st *Table) GetData() []interface{} {
-	return st.data
+// ClearColumnFilter removes the filter on one column, leaving the search filter and
+// other column filters in place
+func (st *Table) ClearColumnFilter(columnID string) {
+	_ = st.SetColumnFilterOp(columnID, FilterContains, "")
 }
 
-// GetUnderlyingTable returns the base Fyne table widget for direct access
-func (st *Table) GetUnderlyingTable() *widget.Table {
-	if st.table != nil {
-		return st.table.Table
-	}
-	return nil
+// SetRowFilter filters rows with a custom predicate, in addition to the search and
+// column filters (a row is shown only if all of them match). The predicate stays in
+// effect across SetData and ClearFilter until ClearRowFilter is called. It is called
+// during row rebuilds and must not call back into the table.
+func (st *Table) SetRowFilter(predicate func(data interface{}) bool) {
+	st.state.rowFilter = predicate
+	st.ApplyFilter()
+	st.logger().Debug("[FILTER] Row filter set", "set", predicate != nil, "visibleRows", len(st.state.visibleRows), "totalRows", len(st.data))
 }
 
-// SetFilter updates the filter text and rebuilds visible rows
-func (st *Table) SetFilter(filterText string, useRegex bool) {
-	st.state.filterText = filterText
-	st.state.filterRegex = useRegex
+// ApplyFilter re-runs the search, column and row filters against the current data and
+// refreshes. Call it when something a SetRowFilter predicate reads has changed:
+//
+//	minPriority := 2
+//	tableWidget.SetRowFilter(func(data interface{}) bool {
+//		task := data.(Task)
+//		return task.Priority >= minPriority && task.Status != "Complete"
+//	})
+//	minPriority = 3
+//	tableWidget.ApplyFilter()
+func (st *Table) ApplyFilter() {
 	st.RebuildVisibleRows()
 	if st.table != nil {
-		// Refresh base table to ensure it detects row count changes
 		// Use Do (not DoAndWait) to avoid deadlock when called from UI thread
 		fyne.Do(func() {
-			st.table.Refresh()
-			st.table.Refresh()
+			st.refreshTable()
 		})
 	}
-	st.logger().Info(fmt.Sprintf("Filter set: text=%q, regex=%v, caseSensitive=%v, visible rows=%d/%d", filterText, useRegex, st.state.filterCaseSensitive, len(st.state.visibleRows), len(st.data)))
+	st.notifyListeners()
+}
+
+// ClearRowFilter removes the custom row predicate set by SetRowFilter
+func (st *Table) ClearRowFilter() {
+	st.SetRowFilter(nil)
 }
 
 // SetFilterCaseSensitive sets whether filtering is case-sensitive
 func (st *Table) SetFilterCaseSensitive(caseSensitive bool) {
 	st.state.filterCaseSensitive = caseSensitive
-	if st.state.filterText != "" {
+	if st.state.HasFilter() {
 		st.RebuildVisibleRows()
 		if st.table != nil {
 			// Refresh base table to ensure it detects row count changes
 			// Use Do (not DoAndWait) to avoid deadlock when called from UI thread
 			fyne.Do(func() {
-				st.table.Refresh()
-				st.table.Refresh()
+				st.refreshTable()
+				st.refreshTable()
 			})
 		}
 	}
+	st.notifyListeners()
 }
 
 // GetFilter returns the current filter text and regex mode
@@ -746,9 +2232,10 @@ func (st *Table) GetFilter() (string, bool) {
 	return st.state.filterText, st.state.filterRegex
 }
 
-// ClearFilter removes the filter and shows all rows
+// ClearFilter removes the filter (including column filters) and shows all rows
 func (st *Table) ClearFilter() {
-	st.SetFilter("", false)
+	st.state.columnFilters = nil
+	_ = st.SetFilter("", false)
 }
 
 // GetFilterToggleCheckbox returns the filter toggle checkbox for external configuration
@@ -792,23 +2279,86 @@ func (st *Table) updateFilterVisibility() {
 
 // CreateRenderer implements fyne.Widget
 func (st *Table) CreateRenderer() fyne.WidgetRenderer {
-	st.logger().Info(fmt.Sprintf("[FILTER] CreateRenderer called: ShowSearch=%v, filterSection=%v", st.config.ShowSearch, st.filterSection != nil))
+	st.logger().Debug("[FILTER] CreateRenderer called", "showSearch", st.config.ShowSearch, "filterSection", st.filterSection != nil)
+
 	// If search/filter UI is enabled, show it above the table
+	var top, bottom fyne.CanvasObject
 	if st.config.ShowSearch && st.filterSection != nil {
-		st.logger().Info("[FILTER] Creating renderer WITH filter section")
+		st.logger().Debug("[FILTER] Creating renderer WITH filter section")
+		top = st.filterSection
+	}
+	// The headerless resize strip sits directly above the table
+	if st.resizeStrip != nil {
+		if top != nil {
+			top = container.NewVBox(top, st.resizeStrip)
+		} else {
+			top = st.resizeStrip
+		}
+	}
+	// If the row count status line is enabled, show it below the table
+	if st.rowCountLabel != nil {
+		bottom = st.rowCountLabel
+	}
+
+	// Open row details are drawn over the table
+	var center fyne.CanvasObject = st.table
+	if st.detailOverlay != nil {
+		center = container.New(&detailOverlayLayout{table: st}, st.table, st.detailOverlay)
+	}
+
+	if top != nil || bottom != nil {
 		content := container.NewBorder(
-			st.filterSection, // top
-			nil,              // bottom
-			nil,              // left
-			nil,              // right
-			st.table,         // center
+			top,    // top
+			bottom, // bottom
+			nil,    // left
+			nil,    // right
+			center, // center
 		)
 		return widget.NewSimpleRenderer(content)
 	}
 
 	// Otherwise just return the table directly
-	st.logger().Info("[FILTER] Creating renderer WITHOUT filter section")
-	return widget.NewSimpleRenderer(st.table)
+	st.logger().Debug("[FILTER] Creating renderer WITHOUT filter section")
+	return widget.NewSimpleRenderer(center)
+}
+
+// GetVisibleRowCount returns the number of data rows currently shown (after filtering,
+// collapsed tree nodes and groups; group header rows are not counted)
+func (st *Table) GetVisibleRowCount() int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return len(st.navigableRows())
+}
+
+// GetTotalRowCount returns the total number of data rows
+func (st *Table) GetTotalRowCount() int {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+	return len(st.data)
+}
+
+// createRowCountLabel creates the row count status line if ShowRowCount is enabled
+func (st *Table) createRowCountLabel() {
+	if !st.config.ShowRowCount {
+		return
+	}
+	st.rowCountPrinter = message.NewPrinter(language.Make(string(lang.SystemLocale())))
+	st.rowCountLabel = widget.NewLabel("")
+	st.rowCountLabel.SizeName = theme.SizeNameCaptionText
+	st.updateRowCount()
+}
+
+// updateRowCount refreshes the row count status line (no-op when it is disabled)
+func (st *Table) updateRowCount() {
+	if st.rowCountLabel == nil {
+		return
+	}
+	st.rowCountLabel.SetText(formatRowCount(st.rowCountPrinter, st.GetVisibleRowCount(), st.GetTotalRowCount()))
+}
+
+// formatRowCount formats the status line text with locale-specific thousands separators
+func formatRowCount(printer *message.Printer, visible, total int) string {
+	return printer.Sprintf("Showing %d of %d rows", visible, total)
 }
 
 // ========================================
@@ -817,6 +2367,9 @@ func (st *Table) CreateRenderer() fyne.WidgetRenderer {
 
 // tableLength returns the number of rows and columns
 func (st *Table) tableLength() (int, int) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
 	if st.state.visibleRows != nil {
 		rows := len(st.state.visibleRows) + 1 // +1 for header row
 		cols := len(st.state.visibleColumns)  // Only count visible columns
@@ -829,20 +2382,75 @@ func (st *Table) tableLength() (int, int) {
 
 // tableCreateCell creates a reusable cell widget
 func (st *Table) tableCreateCell() fyne.CanvasObject {
+	// Fyne sizes every row from this template, so a strut sets the minimum row height
+	strut := canvas.NewRectangle(color.Transparent)
+	strut.SetMinSize(fyne.NewSize(0, st.config.rowHeight()))
+
 	// Create a container that can hold various content (label, entry, buttons, etc.)
 	// If custom font size is configured, use canvas.Text instead of widget.Label
-	if st.config.FontSize > 0 {
+	if size := st.config.fontSize(); size > 0 {
 		text := canvas.NewText("", theme.Color(theme.ColorNameForeground))
-		text.TextSize = st.config.FontSize
-		return container.NewStack(text)
+		text.TextSize = size
+		return container.NewStack(strut, text)
 	}
-	return container.NewStack(widget.NewLabel(""))
+	return container.NewStack(strut, widget.NewLabel(""))
+}
+
+// createFyneHeader creates a cell of Fyne's own header row (the column letters used
+// for drag-resizing). Fyne also sizes rows from this template, so with a custom font
+// size it is text of that size rather than a (taller) label.
+func (st *Table) createFyneHeader() fyne.CanvasObject {
+	if size := st.config.fontSize(); size > 0 {
+		text := canvas.NewText("00", theme.Color(theme.ColorNameForeground))
+		text.TextSize = size
+		text.TextStyle = fyne.TextStyle{Bold: true}
+		text.Alignment = fyne.TextAlignCenter
+		return text
+	}
+	label := widget.NewLabel("00")
+	label.TextStyle = fyne.TextStyle{Bold: true}
+	label.Alignment = fyne.TextAlignCenter
+	return label
+}
+
+// updateFyneHeader shows the column letter in a cell of Fyne's header row, as Fyne does
+func (st *Table) updateFyneHeader(id widget.TableCellID, cell fyne.CanvasObject) {
+	letters := ""
+	if id.Row < 0 {
+		letters = columnLetters(id.Col)
+	}
+	switch header := cell.(type) {
+	case *canvas.Text:
+		header.Text = letters
+		header.TextSize = st.config.fontSize()
+		header.Refresh()
+	case *widget.Label:
+		header.SetText(letters)
+	}
+}
+
+// columnLetters returns a spreadsheet-style column name: A-Z, then AA, AB, ...
+func columnLetters(col int) string {
+	letters := []rune{'A' + rune(col%26)}
+	for col = col/26 - 1; col >= 0; col = col/26 - 1 {
+		letters = append([]rune{'A' + rune(col%26)}, letters...)
+	}
+	return string(letters)
 }
 
 // tableUpdateCell updates a cell with data
 func (st *Table) tableUpdateCell(id widget.TableCellID, cell fyne.CanvasObject) {
+	st.hookTableScroll()
 	container := cell.(*fyne.Container)
 
+	st.mu.RLock()
+	locked := true
+	defer func() {
+		if locked {
+			st.mu.RUnlock()
+		}
+	}()
+
 	// Header row (row 0)
 	if id.Row == 0 {
 		st.renderHeaderCell(id.Col, container)
@@ -860,6 +2468,12 @@ func (st *Table) tableUpdateCell(id widget.TableCellID, cell fyne.CanvasObject)
 		dataIndex = displayRowIndex // Fallback if no filtering
 	}
 
+	// Synthetic group header row
+	if g, ok := groupIndexOf(dataIndex); ok {
+		st.renderGroupHeaderCell(id.Col, g, container)
+		return
+	}
+
 	if dataIndex >= len(st.data) {
 		// Empty cell
 		container.Objects = []fyne.CanvasObject{widget.NewLabel("")}
@@ -867,41 +2481,82 @@ func (st *Table) tableUpdateCell(id widget.TableCellID, cell fyne.CanvasObject)
 		return
 	}
 
+	// Full-width rows replace every cell; the renderer runs without the lock like custom renderers
+	if render := st.config.FullWidthRowRenderer; render != nil {
+		item := st.data[dataIndex]
+		st.mu.RUnlock()
+		locked = false
+		if content := render(dataIndex, item); content != nil {
+			st.renderFullWidthCell(id.Col, content, container)
+			return
+		}
+		st.mu.RLock()
+		locked = true
+		if dataIndex >= len(st.data) { // Data replaced while unlocked
+			container.Objects = []fyne.CanvasObject{widget.NewLabel("")}
+			container.Refresh()
+			return
+		}
+	}
+
 	// Note: Removed excessive logging during cell updates (was causing spam during column resize)
 	// To debug cell rendering, temporarily uncomment the line below:
-	// st.logger().Info(fmt.Sprintf("[UPDATE] tableUpdateCell displayRow=%d dataIndex=%d data=%v",
-	// 	displayRowIndex, dataIndex, st.data[dataIndex]))
+	// st.logger().Debug("[UPDATE] tableUpdateCell", "displayRow", displayRowIndex, "dataIndex", dataIndex,
+	// 	"data", st.data[dataIndex])
+
+	// Custom renderers run without the lock so they can call back into the table
+	if col, ok := st.customRenderedColumn(id.Col, dataIndex); ok {
+		item := st.data[dataIndex]
+		st.mu.RUnlock()
+		locked = false
+		col.Renderer(item, container, dataIndex, col.ID)
+		return
+	}
 
+	// The default renderer reads the cell's state under the lock and calls the user's
+	// callbacks (Formatter, IsRowSelectable, ...) without it
+	st.mu.RUnlock()
+	locked = false
 	st.renderDataCell(id.Col, dataIndex, container)
 }
 
+// customRenderedColumn returns the column for a cell drawn by a custom Renderer
+// (ok is false for cells drawn by renderDataCell, including the cell being edited)
+func (st *Table) customRenderedColumn(displayColIndex int, dataIndex int) (ColumnConfig, bool) {
+	if displayColIndex < 0 || displayColIndex >= len(st.state.visibleColumns) || dataIndex < 0 || dataIndex >= len(st.data) {
+		return ColumnConfig{}, false
+	}
+	colIndex := st.state.visibleColumns[displayColIndex]
+	if colIndex >= len(st.config.Columns) || st.config.Columns[colIndex].Renderer == nil {
+		return ColumnConfig{}, false
+	}
+	if st.state.editingRow == dataIndex && st.state.editingCol == colIndex {
+		return ColumnConfig{}, false
+	}
+	return st.config.Columns[colIndex], true
+}
+
 // renderHeaderCell renders a header cell
-func (st *Table) renderHeaderCell(displayColIndex int, container *fyne.Container) {
+func (st *Table) renderHeaderCell(displayColIndex int, cellContainer *fyne.Container) {
 	// Map display column index to actual column index
 	if displayColIndex >= len(st.state.visibleColumns) {
-		container.Objects = []fyne.CanvasObject{widget.NewLabel("")}
-		container.Refresh()
+		cellContainer.Objects = []fyne.CanvasObject{widget.NewLabel("")}
+		cellContainer.Refresh()
 		return
 	}
 	colIndex := st.state.visibleColumns[displayColIndex]
 
 	if colIndex >= len(st.config.Columns) {
-		container.Objects = []fyne.CanvasObject{widget.NewLabel("")}
-		container.Refresh()
+		cellContainer.Objects = []fyne.CanvasObject{widget.NewLabel("")}
+		cellContainer.Refresh()
 		return
 	}
 
 	col := st.config.Columns[colIndex]
 
 	// Build header text with sort indicator
-	headerText := col.Title
-	if st.state.sortColumn == colIndex {
-		if st.state.sortAsc {
-			headerText += " ▲"
-		} else {
-			headerText += " ▼"
-		}
-	}
+	headerText := st.headerText(col, colIndex)
+	icon := st.sortIndicatorIcon(colIndex)
 
 	label := widget.NewLabel(headerText)
 	label.TextStyle = fyne.TextStyle{Bold: true}
@@ -931,110 +2586,235 @@ func (st *Table) renderHeaderCell(displayColIndex int, container *fyne.Container
 		default:
 			button.Alignment = widget.ButtonAlignLeading
 		}
-		container.Objects = []fyne.CanvasObject{button}
+		if icon != nil {
+			button.Icon = icon
+			button.IconPlacement = widget.ButtonIconTrailingText
+			if col.Alignment == AlignRight {
+				button.IconPlacement = widget.ButtonIconLeadingText
+			}
+		}
+		cellContainer.Objects = []fyne.CanvasObject{withTooltip(button, col.HeaderTooltip)}
+	} else if icon != nil {
+		// Keep the title's aligned edge clear: the icon goes on the opposite side
+		var content fyne.CanvasObject
+		if col.Alignment == AlignRight {
+			content = container.NewBorder(nil, nil, widget.NewIcon(icon), nil, label)
+		} else {
+			content = container.NewBorder(nil, nil, nil, widget.NewIcon(icon), label)
+		}
+		cellContainer.Objects = []fyne.CanvasObject{withTooltip(content, col.HeaderTooltip)}
 	} else {
-		container.Objects = []fyne.CanvasObject{label}
+		cellContainer.Objects = []fyne.CanvasObject{withTooltip(label, col.HeaderTooltip)}
 	}
 
-	container.Refresh()
+	cellContainer.Refresh()
 }
 
-// renderDataCell renders a data cell (private helper)
-func (st *Table) renderDataCell(displayColIndex int, dataIndex int, cellContainer *fyne.Container) {
-	// Map display column index to actual column index
-	if displayColIndex >= len(st.state.visibleColumns) {
-		cellContainer.Objects = []fyne.CanvasObject{widget.NewLabel("")}
-		cellContainer.Refresh()
-		return
+// headerText returns a column's header title with the configured sort indicator text.
+// For right-aligned columns the indicator precedes the title so the title stays
+// flush with the column's values.
+func (st *Table) headerText(col ColumnConfig, colIndex int) string {
+	indicator := st.sortIndicatorText(colIndex)
+	switch {
+	case indicator == "":
+		return col.Title
+	case col.Alignment == AlignRight:
+		return indicator + " " + col.Title
+	default:
+		return col.Title + " " + indicator
 	}
-	colIndex := st.state.visibleColumns[displayColIndex]
+}
 
-	if colIndex >= len(st.config.Columns) || dataIndex >= len(st.data) {
-		cellContainer.Objects = []fyne.CanvasObject{widget.NewLabel("")}
+// sortIndicatorText returns the indicator text for a column, or "" if it is not a
+// sort column. When sorting by several columns the text ends with the column's sort
+// priority (e.g. "▲2"); with SortIndicatorAsIcon it is only the priority.
+func (st *Table) sortIndicatorText(colIndex int) string {
+	keys := st.state.sortKeys()
+	pos := sortKeyIndex(keys, colIndex)
+	if pos < 0 {
+		return ""
+	}
+	priority := ""
+	if len(keys) > 1 {
+		priority = strconv.Itoa(pos + 1)
+	}
+	switch {
+	case st.config.SortIndicatorAsIcon:
+		return priority
+	case keys[pos].asc:
+		return st.config.SortAscIndicator + priority
+	default:
+		return st.config.SortDescIndicator + priority
+	}
+}
+
+// sortIndicatorIcon returns the sort direction icon for a column when
+// SortIndicatorAsIcon is set and the column is sorted, or nil
+func (st *Table) sortIndicatorIcon(colIndex int) fyne.Resource {
+	keys := st.state.sortKeys()
+	pos := sortKeyIndex(keys, colIndex)
+	if pos < 0 || !st.config.SortIndicatorAsIcon {
+		return nil
+	}
+	if keys[pos].asc {
+		return theme.MenuDropUpIcon()
+	}
+	return theme.MenuDropDownIcon()
+}
+
+// renderGroupHeaderCell renders a group header row: a shaded band across all columns
+// with a clickable collapse toggle and summary in the first column
+func (st *Table) renderGroupHeaderCell(displayColIndex int, groupIndex int, cellContainer *fyne.Container) {
+	background := canvas.NewRectangle(theme.Color(theme.ColorNameHeaderBackground))
+	if displayColIndex != 0 || groupIndex >= len(st.state.groups) {
+		cellContainer.Objects = []fyne.CanvasObject{background}
 		cellContainer.Refresh()
 		return
 	}
 
-	col := st.config.Columns[colIndex]
-	data := st.data[dataIndex]
-
-	// Check if this cell is being edited
-	if st.state.editingRow == dataIndex && st.state.editingCol == colIndex {
-		st.logger().Info(fmt.Sprintf("[DEBUG] renderDataCell: Rendering EDIT widget for row=%d col=%d", dataIndex, colIndex))
-		// Show entry widget for editing with ESC/Enter handling
-		var escEntry *escapeableEntry
-		if st.editingEntry == nil {
-			// Create escapeable entry that handles ESC and Enter keys
-			escEntry = newEscapeableEntry(st.state.editingValue, st.cancelEdit, st.saveEdit)
-			st.editingEntry = &escEntry.Entry // Keep reference to underlying Entry
-		} else {
-			// Recreate the escapeable entry with current entry value
-			escEntry = newEscapeableEntry(st.editingEntry.Text, st.cancelEdit, st.saveEdit)
-			st.editingEntry = &escEntry.Entry
-		}
+	group := st.state.groups[groupIndex]
+	button := widget.NewButton(st.groupHeaderText(group), func() {
+		st.ToggleGroupCollapsed(group.value)
+	})
+	button.Importance = widget.LowImportance
+	button.Alignment = widget.ButtonAlignLeading
 
-		cellContainer.Objects = []fyne.CanvasObject{escEntry}
-		cellContainer.Refresh()
+	cellContainer.Objects = []fyne.CanvasObject{background, button}
+	cellContainer.Refresh()
+}
 
-		// Focus the entry widget after it's in the canvas tree
-		// Use goroutine with small delay to ensure Refresh completes and canvas is ready
-		go func() {
-			// Small delay to ensure canvas is ready (50ms should be sufficient)
-			time.Sleep(50 * time.Millisecond)
-			// All UI operations must run on the UI thread
-			// Use Do (not DoAndWait) to avoid deadlock
-			fyne.Do(func() {
-				if canvas := fyne.CurrentApp().Driver().CanvasForObject(escEntry); canvas != nil {
-					canvas.Focus(escEntry)
-					// Select all text so user can immediately type to replace
-					// Use Ctrl+A shortcut to trigger selection
-					escEntry.TypedShortcut(&desktop.CustomShortcut{
-						KeyName:  fyne.KeyA,
-						Modifier: fyne.KeyModifierControl,
-					})
-				} else {
-					st.logger().Warn("Could not get canvas for edit entry (after 50ms delay)")
-				}
-			})
-		}()
-		return
+// renderFullWidthCell renders a cell of a full-width row: the first column hosts the
+// row's widget and the other columns are blank. The widget is limited to the first
+// column's width since Fyne tables can't span cells.
+func (st *Table) renderFullWidthCell(displayColIndex int, content fyne.CanvasObject, cellContainer *fyne.Container) {
+	if displayColIndex != 0 {
+		cellContainer.Objects = []fyne.CanvasObject{widget.NewLabel("")}
+	} else {
+		cellContainer.Objects = []fyne.CanvasObject{content}
 	}
+	cellContainer.Refresh()
+}
 
-	// If custom renderer provided, use it
-	if col.Renderer != nil {
-		col.Renderer(data, cellContainer, dataIndex, col.ID)
-		return
+// dataCell is the table state renderDataCell draws a cell from, copied under st.mu
+// so the user callbacks it calls (Formatter, GetCellValue, IsRowSelectable, ...) run
+// without the lock and can call back into the table
+type dataCell struct {
+	col           ColumnConfig
+	colIndex      int
+	data          interface{}
+	dataIndex     int
+	displayCol    int
+	lastCol       bool           // Last visible column (selection border on the right)
+	editing       bool           // The cell is being edited
+	highlightCell bool           // Selected cell (or any cell of a selected row in row-only mode)
+	tintRow       bool           // Other cells of the selected row with HighlightFullRow
+	detailOpen    bool           // The row's detail is open (drawn by the detail overlay)
+	highlight     *regexp.Regexp // Search matches to show in bold (nil = none)
+}
+
+// dataCellState returns the state for drawing a data cell, or false if the display
+// column or data row doesn't exist. Caller must hold st.mu.
+func (st *Table) dataCellState(displayColIndex int, dataIndex int) (dataCell, bool) {
+	// Map display column index to actual column index
+	if displayColIndex < 0 || displayColIndex >= len(st.state.visibleColumns) {
+		return dataCell{}, false
+	}
+	colIndex := st.state.visibleColumns[displayColIndex]
+	if colIndex >= len(st.config.Columns) || dataIndex < 0 || dataIndex >= len(st.data) {
+		return dataCell{}, false
 	}
 
-	// Default renderer: extract and display the specific field
-	fieldValue := st.extractFieldValue(data, col.ID)
+	cell := dataCell{
+		col:        st.config.Columns[colIndex],
+		colIndex:   colIndex,
+		data:       st.data[dataIndex],
+		dataIndex:  dataIndex,
+		displayCol: displayColIndex,
+		lastCol:    displayColIndex == len(st.state.visibleColumns)-1,
+		editing:    st.state.editingRow == dataIndex && st.state.editingCol == colIndex,
+		detailOpen: st.hasOpenDetail(dataIndex),
+		highlight:  st.searchHighlight,
+	}
 
-	// Determine if this cell should be highlighted FIRST
-	highlightCell := false
 	// Check if this row is selected (handles both single and multi-select)
 	if st.state.IsRowSelected(dataIndex) {
 		if st.config.RowSelectOnlyMode {
 			// In row-only mode: highlight ALL visible columns on the selected row
-			highlightCell = true
+			cell.highlightCell = true
 		} else {
-			// In row-column mode: highlight only the selected cell
-			if st.state.selectedCol == colIndex {
-				// Verify selectedCol is actually visible
-				for _, visCol := range st.state.visibleColumns {
-					if visCol == st.state.selectedCol {
-						highlightCell = true
-						break
-					}
-				}
+			// In row-column mode: highlight only the selected cell (selectedCol is
+			// visible, as this column is)
+			cell.highlightCell = st.state.selectedCol == colIndex
+			// The active cell's highlight takes precedence over the row tint
+			cell.tintRow = st.config.HighlightFullRow && !cell.highlightCell
+		}
+	}
+	return cell, true
+}
+
+// renderDataCell renders a data cell (private helper). The cell's state is read under
+// st.mu, which must not be held by the caller; the cell is then built without the lock.
+func (st *Table) renderDataCell(displayColIndex int, dataIndex int, cellContainer *fyne.Container) {
+	st.mu.RLock()
+	cell, ok := st.dataCellState(displayColIndex, dataIndex)
+	editingValue := st.state.editingValue
+	st.mu.RUnlock()
+	if !ok {
+		cellContainer.Objects = []fyne.CanvasObject{widget.NewLabel("")}
+		cellContainer.Refresh()
+		return
+	}
+
+	col, colIndex, data := cell.col, cell.colIndex, cell.data
+	highlightCell, tintRow := cell.highlightCell, cell.tintRow
+
+	// Check if this cell is being edited
+	if cell.editing {
+		st.logger().Debug("[EDIT] renderDataCell: rendering edit widget", "row", dataIndex, "col", colIndex)
+		// Show entry widget for editing with ESC/Enter handling (check and select
+		// box editors of EditBool / EditSelect columns are created by startEdit).
+		// The widget is created once per edit and reused across refreshes so the
+		// caret and text in progress survive background updates.
+		if st.editingEntry == nil && st.editingWidget == nil {
+			text, selectAll := editingValue, true
+			if st.editTypedText != "" {
+				// Type-to-edit: the typed text replaces the value, caret after it
+				text, selectAll = st.editTypedText, false
+				st.editTypedText = ""
+			}
+			st.editingEntry = newEscapeableEntry(text, st.cancelEdit, st.saveEdit)
+			st.editingEntry.CursorColumn = utf8.RuneCountInString(text)
+			if col.EditType == EditNumber {
+				st.editingEntry.Validator = validateNumber
 			}
+			st.focusEditEntry(st.editingEntry, selectAll)
+		}
+		var editor fyne.CanvasObject = st.editingEntry
+		if st.editingWidget != nil {
+			editor = st.editingWidget
+		}
+
+		if len(cellContainer.Objects) != 1 || cellContainer.Objects[0] != editor {
+			cellContainer.Objects = []fyne.CanvasObject{editor}
+			cellContainer.Refresh()
 		}
+		return
+	}
+
+	// Default renderer: extract and display the specific field (through a popup column's GetCellValue or the column formatter, if any)
+	fieldValue := st.cellDisplayText(data, col)
+	placeholder := fieldValue == "" && col.EmptyPlaceholder != ""
+	if placeholder {
+		fieldValue = col.EmptyPlaceholder
 	}
+	muted := placeholder || !st.isItemSelectable(dataIndex, data) // Placeholders and disabled rows
 
 	// Create or update the content widget
 	var content fyne.CanvasObject
+	selectable := st.config.SelectableCells && highlightCell && !placeholder
 
 	// If custom font size is configured, use canvas.Text instead of widget.Label
-	if st.config.FontSize > 0 {
+	if fontSize := st.config.fontSize(); fontSize > 0 {
 		var text *canvas.Text
 		// Try to reuse existing text widget (unwrap from container if highlighted)
 		if len(cellContainer.Objects) > 0 {
@@ -1053,7 +2833,11 @@ func (st *Table) renderDataCell(displayColIndex int, dataIndex int, cellContaine
 		} else {
 			text.Text = fieldValue
 		}
-		text.TextSize = st.config.FontSize
+		text.TextSize = fontSize
+		text.Color = theme.Color(theme.ColorNameForeground)
+		if muted {
+			text.Color = theme.Color(theme.ColorNameDisabled)
+		}
 
 		// Apply text alignment
 		switch col.Alignment {
@@ -1065,6 +2849,9 @@ func (st *Table) renderDataCell(displayColIndex int, dataIndex int, cellContaine
 			text.Alignment = fyne.TextAlignLeading
 		}
 		content = text
+	} else if matches := st.highlightedText(fieldValue, col, cell.highlight, muted); matches != nil && !placeholder && !selectable {
+		// Search matches shown in bold (HighlightMatches)
+		content = matches
 	} else {
 		// Use standard widget.Label for default size
 		var label *widget.Label
@@ -1083,6 +2870,12 @@ func (st *Table) renderDataCell(displayColIndex int, dataIndex int, cellContaine
 		if label == nil {
 			label = widget.NewLabel("")
 		}
+		label.Importance = widget.MediumImportance
+		if muted {
+			label.Importance = widget.LowImportance
+		}
+		// Only selected cells take text selection, so clicks on other cells still select rows
+		label.Selectable = selectable
 		label.SetText(fieldValue)
 
 		// Apply text alignment
@@ -1097,6 +2890,20 @@ func (st *Table) renderDataCell(displayColIndex int, dataIndex int, cellContaine
 		content = label
 	}
 
+	// Popup column: a trailing dropdown icon marks the cell as interactive
+	if st.showsPopupIcon(col) {
+		content = container.NewBorder(nil, nil, nil, widget.NewIcon(theme.MenuDropDownIcon()), content)
+	}
+
+	// Tree column: prefix indentation and the expand/collapse toggle
+	if st.isTreeColumn(col) {
+		content = st.wrapTreeCell(data, dataIndex, content)
+	}
+	if cell.detailOpen {
+		// Content at the top of the enlarged row; the detail overlay fills the rest
+		content = container.NewBorder(content, nil, nil, nil)
+	}
+
 	// Apply or remove highlighting based on selection state
 	if highlightCell {
 		// Wrap the content with selection background using Max container (stacks objects)
@@ -1105,7 +2912,7 @@ func (st *Table) renderDataCell(displayColIndex int, dataIndex int, cellContaine
 		// Add border around the row (thin line on edges)
 		// Determine which borders this cell needs based on its position
 		isFirstCol := displayColIndex == 0
-		isLastCol := displayColIndex == len(st.state.visibleColumns)-1
+		isLastCol := cell.lastCol
 
 		// Create border lines (1 pixel width)
 		borderColor := theme.Color(theme.ColorNamePrimary)
@@ -1140,54 +2947,245 @@ func (st *Table) renderDataCell(displayColIndex int, dataIndex int, cellContaine
 				container.NewBorder(topBorder, bottomBorder, leftBorder, rightBorder, content),
 			),
 		}
+	} else if tintRow {
+		// Rest of the selected row: light tint, no border
+		cellContainer.Objects = []fyne.CanvasObject{
+			container.NewStack(canvas.NewRectangle(rowTintColor()), content),
+		}
 	} else {
 		// No highlighting - just the content
 		cellContainer.Objects = []fyne.CanvasObject{content}
 	}
 
-	cellContainer.Refresh()
+	cellContainer.Refresh()
+}
+
+// highlightedText returns text as rich text with the parts highlight matches in bold
+// primary-colored segments, or nil if Config.HighlightMatches is off, col isn't searched
+// or nothing in text matches. Only rendered cells are styled.
+func (st *Table) highlightedText(text string, col ColumnConfig, highlight *regexp.Regexp, muted bool) *widget.RichText {
+	if !st.config.HighlightMatches || highlight == nil || !slices.Contains(st.searchColumns(), col.ID) {
+		return nil
+	}
+
+	var segments []widget.RichTextSegment
+	add := func(part string, matched bool) {
+		if part == "" {
+			return
+		}
+		style := widget.RichTextStyleInline
+		switch col.Alignment {
+		case AlignCenter:
+			style.Alignment = fyne.TextAlignCenter
+		case AlignRight:
+			style.Alignment = fyne.TextAlignTrailing
+		}
+		if muted {
+			style.ColorName = theme.ColorNameDisabled
+		}
+		if matched {
+			style.ColorName = theme.ColorNamePrimary
+			style.TextStyle.Bold = true
+		}
+		segments = append(segments, &widget.TextSegment{Text: part, Style: style})
+	}
+
+	last, matched := 0, false
+	for _, span := range highlight.FindAllStringIndex(text, -1) {
+		if span[0] == span[1] {
+			continue // Empty regex match, e.g. "a*"
+		}
+		add(text[last:span[0]], false)
+		add(text[span[0]:span[1]], true)
+		last, matched = span[1], true
+	}
+	if !matched {
+		return nil
+	}
+	add(text[last:], false)
+	return widget.NewRichText(segments...)
+}
+
+// rowTintColor returns the background for the selected row's other cells with
+// HighlightFullRow: the selection color at half its opacity
+func rowTintColor() color.Color {
+	tint := color.NRGBAModel.Convert(theme.Color(theme.ColorNameSelection)).(color.NRGBA)
+	tint.A /= 2
+	return tint
+}
+
+// isTreeColumn reports whether the default renderer draws tree visuals in col,
+// set by ColumnConfig.TreeColumn or Config.TreeColumn
+func (st *Table) isTreeColumn(col ColumnConfig) bool {
+	return col.TreeColumn || (st.config.TreeColumn != "" && col.ID == st.config.TreeColumn)
+}
+
+// wrapTreeCell lays out a tree column cell: indentation for the node depth, then a
+// clickable ▶/▼ toggle for expandable nodes (or the theme icon for leaves), then content
+func (st *Table) wrapTreeCell(data interface{}, dataIndex int, content fyne.CanvasObject) fyne.CanvasObject {
+	depth := 0
+	if st.config.GetNodeDepth != nil {
+		depth = st.config.GetNodeDepth(data)
+	}
+
+	var prefix []fyne.CanvasObject
+	if st.config.ShowIndentation && depth > 0 {
+		indentPerLevel := st.config.IndentPerLevel
+		if indentPerLevel == 0 {
+			indentPerLevel = 20.0 // Default if not set
+		}
+		spacer := canvas.NewRectangle(color.Transparent)
+		spacer.SetMinSize(fyne.NewSize(float32(depth)*indentPerLevel, 0))
+		prefix = append(prefix, spacer)
+	}
+
+	if st.config.IsNodeExpandable != nil && st.config.GetNodeID != nil && st.config.IsNodeExpandable(data) {
+		indicator := "▶"
+		if st.isNodeExpanded(st.config.GetNodeID(data)) {
+			indicator = "▼"
+		}
+		toggle := widget.NewButton(indicator, func() {
+			st.ToggleNodeExpansion(dataIndex)
+		})
+		toggle.Importance = widget.LowImportance
+		prefix = append(prefix, toggle)
+	} else if st.config.ShowIndentIcons && depth > 0 {
+		prefix = append(prefix, widget.NewLabel(st.treeIconText(depth)))
+	}
+
+	if len(prefix) == 0 {
+		return content
+	}
+	return container.NewBorder(nil, nil, container.NewHBox(prefix...), nil, content)
 }
 
-// sortData sorts the data based on current sort column and direction
-func (st *Table) sortData() {
-	if st.state.sortColumn < 0 || st.state.sortColumn >= len(st.config.Columns) {
-		return
+// treeIconText returns the branch + theme icon text for a node at the given depth (depth > 0)
+func (st *Table) treeIconText(depth int) string {
+	iconText := ""
+	if st.config.ShowBranch {
+		iconText = st.config.TreeIconTheme.Branch
+	}
+	if len(st.config.TreeIconTheme.Icons) > 0 {
+		iconIndex := (depth - 1) % len(st.config.TreeIconTheme.Icons)
+		iconText += st.config.TreeIconTheme.Icons[iconIndex]
 	}
+	return iconText
+}
 
-	col := st.config.Columns[st.state.sortColumn]
+// sortData sorts the data by the current sort keys
+func (st *Table) sortData() {
+	st.sortDataCtx(context.Background())
+}
 
-	st.logger().Info(fmt.Sprintf("[SORT] sortData called: sortColumn=%d (ID='%s', Title='%s') sortAsc=%v dataLen=%d",
-		st.state.sortColumn, col.ID, col.Title, st.state.sortAsc, len(st.data)))
+// sortDataCtx sorts the data unless ctx is cancelled part way, in which case it
+// returns false and leaves the data unchanged. The caller must hold st.mu.
+func (st *Table) sortDataCtx(ctx context.Context) bool {
+	if st.state.sortColumn < 0 || st.state.sortColumn >= len(st.config.Columns) {
+		return true
+	}
 
-	// Use custom comparator if provided, otherwise use default string comparator
-	comparator := col.Comparator
-	if comparator == nil {
-		st.logger().Info(fmt.Sprintf("[SORT] Using default STRING comparator for column '%s'", col.ID))
-		comparator = NewStringComparator(col.ID)
-	} else {
-		st.logger().Info(fmt.Sprintf("[SORT] Using CUSTOM comparator for column '%s'", col.ID))
+	// One comparator per sort key, in priority order
+	var comparators []SortComparator
+	var ascending []bool
+	for _, key := range st.state.sortKeys() {
+		if key.column >= len(st.config.Columns) {
+			continue
+		}
+		col := st.config.Columns[key.column]
+		st.logger().Debug("[SORT] sortData key", "priority", len(comparators)+1, "column", col.ID, "title", col.Title,
+			"asc", key.asc, "rows", len(st.data))
+		comparators = append(comparators, st.columnComparator(col))
+		ascending = append(ascending, key.asc)
 	}
 
-	sort.Slice(st.data, func(i, j int) bool {
-		cmpResult := comparator(st.data[i], st.data[j])
-		if st.state.sortAsc {
-			return cmpResult < 0
+	// Sort a copy so a cancelled sort leaves the data untouched. Once cancelled, the
+	// remaining comparisons return immediately instead of running the comparators.
+	// The sort is stable: rows that compare equal keep their order, so re-sorting by a
+	// low-cardinality column (e.g. status) doesn't shuffle the rows within each value.
+	sorted := slices.Clone(st.data)
+	comparisons, cancelled := 0, false
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if comparisons++; cancelled || (comparisons%cancelCheckInterval == 0 && ctx.Err() != nil) {
+			cancelled = true
+			return false
+		}
+		// Each key only breaks the ties left by the keys before it
+		for k, comparator := range comparators {
+			cmpResult := comparator(sorted[i], sorted[j])
+			switch {
+			case cmpResult == 0:
+				continue
+			case cmpResult == SortFirst:
+				return true // Placed regardless of direction (e.g. NilsFirst)
+			case cmpResult == SortLast:
+				return false
+			case ascending[k]:
+				return cmpResult < 0
+			default:
+				return cmpResult > 0
+			}
 		}
-		return cmpResult > 0
+		return false
 	})
+	if cancelled {
+		st.logger().Debug("[SORT] Sort superseded", "comparisons", comparisons)
+		return false
+	}
+
+	st.closeDetails() // Sorting moves rows to other data indices
+	copy(st.data, sorted)
 
 	if len(st.data) > 0 {
 		firstItem := fmt.Sprintf("%v", st.data[0])
-		st.logger().Info(fmt.Sprintf("[SORT] Sort complete, firstItem=%s", firstItem))
+		st.logger().Debug("[SORT] Sort complete", "firstItem", firstItem)
+	}
+	return true
+}
+
+// columnComparator returns the comparator a column sorts by: its custom comparator if
+// provided, then its sort key, otherwise the default string comparator
+func (st *Table) columnComparator(col ColumnConfig) SortComparator {
+	switch {
+	case col.Comparator != nil:
+		st.logger().Debug("[SORT] Using custom comparator", "column", col.ID)
+		return col.Comparator
+	case col.SortKey != nil:
+		st.logger().Debug("[SORT] Using sort key", "column", col.ID)
+		return newSortKeyComparator(col.SortKey)
+	default:
+		st.logger().Debug("[SORT] Using default string comparator", "column", col.ID)
+		return NewStringComparator(col.ID)
+	}
+}
+
+// clearSort removes the sort and restores the order the data was set in.
+// Caller must hold st.mu.
+func (st *Table) clearSort() {
+	st.state.ClearSort()
+	if len(st.unsortedData) == len(st.data) {
+		copy(st.data, st.unsortedData)
+	}
+}
+
+// newSortKeyComparator compares rows by the string key returned by sortKey
+func newSortKeyComparator(sortKey func(data interface{}) string) SortComparator {
+	return func(a, b interface{}) int {
+		return strings.Compare(sortKey(a), sortKey(b))
 	}
 }
 
 // startEdit begins editing a cell
 func (st *Table) startEdit(dataIndex int, colIndex int) {
-	st.logger().Info(fmt.Sprintf("[DEBUG] startEdit called: dataIndex=%d colIndex=%d", dataIndex, colIndex))
+	st.logger().Debug("[EDIT] startEdit called", "row", dataIndex, "col", colIndex)
+
+	if !st.isRowSelectable(dataIndex) || st.isFullWidthRow(dataIndex) {
+		return // Disabled and full-width rows are not editable
+	}
 
 	st.state.editingRow = dataIndex
 	st.state.editingCol = colIndex
+	st.editingEntry = nil // New entry is created on first render of this edit
+	st.editingWidget = nil
 
 	// Store original value - extract the specific field
 	data := st.data[dataIndex]
@@ -1197,7 +3195,7 @@ func (st *Table) startEdit(dataIndex int, colIndex int) {
 	// For prototype: use reflection to get field by column ID
 	st.state.editingValue = st.extractFieldValue(data, colID)
 
-	st.logger().Info(fmt.Sprintf("[DEBUG] Editing value: %s", st.state.editingValue))
+	st.logger().Debug("[EDIT] Editing value", "value", st.state.editingValue)
 
 	// Find the display column index for this actual column index
 	displayColIndex := -1
@@ -1209,32 +3207,109 @@ func (st *Table) startEdit(dataIndex int, colIndex int) {
 	}
 
 	if displayColIndex < 0 {
-		st.logger().Error(fmt.Sprintf("Column %d not in visibleColumns, cannot edit", colIndex))
+		st.logger().Error("Column not in visibleColumns, cannot edit", "col", colIndex)
 		st.state.editingRow = -1
 		st.state.editingCol = -1
 		return
 	}
 
-	st.logger().Info(fmt.Sprintf("[DEBUG] Display column index: %d (for actual col %d)", displayColIndex, colIndex))
+	st.logger().Debug("[EDIT] Display column index", "displayCol", displayColIndex, "col", colIndex)
+
+	// Check and select box editors are built here rather than on render, so the
+	// column's PopupOptions and GetCellValue don't run inside cell updates
+	switch col := st.config.Columns[colIndex]; col.EditType {
+	case EditBool:
+		st.editingWidget = st.newEditCheck(st.state.editingValue)
+	case EditSelect:
+		st.editingWidget = st.newEditSelect(data, col, st.state.editingValue)
+	}
 
 	// Refresh the specific cell to trigger renderDataCell with editing state
-	if st.table != nil {
-		// TableCellID uses display indices: Row is dataIndex+1 (header is row 0), Col is display column
-		cellID := widget.TableCellID{Row: dataIndex + 1, Col: displayColIndex}
-		st.logger().Info(fmt.Sprintf("[DEBUG] Refreshing cell: row=%d col=%d", cellID.Row, cellID.Col))
-		st.table.RefreshItem(cellID)
+	st.refreshCell(dataIndex, colIndex)
+}
+
+// Edit entry focus retry schedule (see focusEditEntry)
+const (
+	editFocusInitialDelay = 5 * time.Millisecond // Delay before the first retry, doubled per retry
+	editFocusMaxAttempts  = 8                    // Attempts before giving up (~635ms in total)
+)
+
+// focusEditEntry focuses a newly created edit entry (once per edit), selecting its
+// text if selectAll is set so typing replaces it.
+//
+// The entry is created inside renderDataCell, before Fyne has attached it to a
+// canvas, so CanvasForObject still returns nil at that point. Instead of sleeping
+// for a fixed time, each attempt runs on the UI thread via fyne.Do (which queues it
+// behind the refresh in progress): if the entry is in a canvas it is focused,
+// otherwise the attempt is rescheduled after a delay that starts at
+// editFocusInitialDelay and doubles, up to editFocusMaxAttempts. Attempts stop
+// early if the edit ends or a new edit replaces this entry.
+func (st *Table) focusEditEntry(escEntry *escapeableEntry, selectAll bool) {
+	if fyne.CurrentApp() == nil {
+		return // No app running (e.g. headless tests)
 	}
+	st.tryFocusEditEntry(escEntry, selectAll, 1, editFocusInitialDelay)
+}
+
+// tryFocusEditEntry performs one focus attempt and schedules the next one if needed
+func (st *Table) tryFocusEditEntry(escEntry *escapeableEntry, selectAll bool, attempt int, retryDelay time.Duration) {
+	// All UI operations must run on the UI thread
+	// Use Do (not DoAndWait) to avoid deadlock
+	fyne.Do(func() {
+		if st.editingEntry != escEntry {
+			return // Edit finished or restarted with a new entry
+		}
+
+		app := fyne.CurrentApp()
+		if app == nil {
+			return
+		}
+		if canvas := app.Driver().CanvasForObject(escEntry); canvas != nil {
+			canvas.Focus(escEntry)
+			// Select all text so user can immediately type to replace
+			if selectAll {
+				escEntry.TypedShortcut(&fyne.ShortcutSelectAll{})
+			}
+			return
+		}
+
+		if attempt >= editFocusMaxAttempts {
+			st.logger().Warn("Could not get canvas for edit entry", "attempts", attempt)
+			return
+		}
+		time.AfterFunc(retryDelay, func() {
+			st.tryFocusEditEntry(escEntry, selectAll, attempt+1, retryDelay*2)
+		})
+	})
 }
 
-// saveEdit saves the edited value
+// saveEdit saves the text of the edit entry. Text the column's EditType can't parse
+// (e.g. letters in an EditNumber column) is rejected: the edit stays open and the
+// entry shows the error.
 func (st *Table) saveEdit() {
 	if st.state.editingRow < 0 || st.state.editingCol < 0 || st.editingEntry == nil {
 		return
 	}
+	if err := st.editingEntry.Validate(); err != nil {
+		st.logger().Debug("[EDIT] Rejected value", "value", st.editingEntry.Text, "error", err)
+		return
+	}
+
+	newValue := st.editingEntry.Text
+	if st.config.Columns[st.state.editingCol].EditType == EditNumber {
+		newValue = strings.TrimSpace(newValue)
+	}
+	st.commitEdit(newValue)
+}
+
+// commitEdit ends the edit, reporting newValue to OnViewData and OnCellEdited
+func (st *Table) commitEdit(newValue string) {
+	if st.state.editingRow < 0 || st.state.editingCol < 0 {
+		return
+	}
 
 	editedRow := st.state.editingRow
 	editedCol := st.state.editingCol
-	newValue := st.editingEntry.Text
 	data := st.data[st.state.editingRow]
 	col := st.config.Columns[st.state.editingCol]
 
@@ -1256,19 +3331,12 @@ func (st *Table) saveEdit() {
 	st.state.editingRow = -1
 	st.state.editingCol = -1
 	st.editingEntry = nil
+	st.editingWidget = nil
+	st.editTypedText = ""
 	st.state.editingValue = ""
 
-	if st.table != nil {
-		// Refresh the entire table to restore custom renderers
-		st.table.Refresh()
-		// Also refresh the specific cell to ensure custom renderer is applied
-		if editedRow >= 0 && editedCol >= 0 {
-			st.table.RefreshItem(widget.TableCellID{Row: editedRow + 1, Col: editedCol})
-		}
-	}
-
-	// Restore focus and selection to the table
-	st.SetSelectedCell(editedRow, editedCol)
+	// Restore selection to the edited cell, re-rendering it without the entry
+	st.moveSelectionTo(editedRow, editedCol)
 	st.RequestFocus()
 }
 
@@ -1289,77 +3357,160 @@ func (st *Table) cancelEdit() {
 	st.state.editingRow = -1
 	st.state.editingCol = -1
 	st.editingEntry = nil
+	st.editingWidget = nil
+	st.editTypedText = ""
 	st.state.editingValue = ""
 
-	if st.table != nil {
-		// Refresh the entire table to restore custom renderers
-		st.table.Refresh()
-		// Also refresh the specific cell to ensure custom renderer is applied
-		if editedRow >= 0 && editedCol >= 0 {
-			st.table.RefreshItem(widget.TableCellID{Row: editedRow + 1, Col: editedCol})
-		}
+	// Restore selection to the edited cell, re-rendering it without the entry
+	st.moveSelectionTo(editedRow, editedCol)
+	st.RequestFocus()
+}
+
+// decimalNumber matches the plain decimal numbers accepted by EditNumber columns:
+// optional sign, digits with an optional fraction, and an optional exponent
+var decimalNumber = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// validateNumber is the edit entry validator of EditNumber columns. Unlike
+// strconv.ParseFloat it rejects "NaN", "Inf" and hexadecimal floats.
+func validateNumber(text string) error {
+	text = strings.TrimSpace(text)
+	if !decimalNumber.MatchString(text) {
+		return fmt.Errorf("not a number")
 	}
+	if _, err := strconv.ParseFloat(text, 64); err != nil {
+		return fmt.Errorf("not a number") // Out of range
+	}
+	return nil
+}
 
-	// Restore focus and selection to the table
-	st.SetSelectedCell(editedRow, editedCol)
-	st.RequestFocus()
+// newEditCheck creates the check of an EditBool edit, saving "true" or "false" when
+// toggled. It starts from the edited value, which is checked if it parses as true.
+func (st *Table) newEditCheck(value string) *widget.Check {
+	checked, _ := strconv.ParseBool(value)
+	check := widget.NewCheck("", nil)
+	check.SetChecked(checked)
+	check.OnChanged = func(checked bool) {
+		st.commitEdit(strconv.FormatBool(checked))
+	}
+	return check
+}
+
+// newEditSelect creates the select box of an EditSelect edit, offering the column's
+// PopupOptions for data and saving the option chosen. It starts on the cell's value
+// (GetCellValue if set) when that is one of the options.
+func (st *Table) newEditSelect(data interface{}, col ColumnConfig, value string) *widget.Select {
+	var options []string
+	if col.PopupOptions != nil {
+		options = col.PopupOptions(data)
+	}
+	current := value
+	if col.GetCellValue != nil {
+		current = col.GetCellValue(data)
+	}
+
+	sel := widget.NewSelect(options, nil)
+	sel.SetSelected(current)
+	sel.OnChanged = func(option string) {
+		st.commitEdit(option)
+	}
+	return sel
 }
 
 // extractFieldValue tries to extract a field value from data by column ID
 func (st *Table) extractFieldValue(data interface{}, colID string) string {
-	if data == nil {
+	raw := st.extractFieldRaw(data, colID)
+	if raw == nil {
 		return ""
 	}
+	return fmt.Sprintf("%v", raw)
+}
 
-	// Try reflection to get field by name (capitalize first letter for exported fields)
-	v := reflect.ValueOf(data)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
+// extractFieldRaw returns the raw value of the field matching colID (a field name or
+// dotted path such as "Customer.Email"), or data itself when it is not a struct or map
+// or has no such field. Missing map keys and nil values along a path yield nil.
+func (st *Table) extractFieldRaw(data interface{}, colID string) interface{} {
+	if data == nil {
+		return nil
 	}
 
-	if v.Kind() != reflect.Struct {
-		return fmt.Sprintf("%v", data)
+	if value, ok := resolveFieldPath(data, colID); ok {
+		return value
+	}
+	if isFieldPath(colID) || isRecordValue(data) {
+		return nil // Displays as blank
 	}
 
-	// Try exact match first
-	field := v.FieldByName(colID)
-	if !field.IsValid() {
-		// Try capitalized version (e.g., "name" -> "Name")
-		caser := cases.Title(language.English)
-		capitalized := caser.String(colID)
-		field = v.FieldByName(capitalized)
+	// Fallback to the item itself
+	return data
+}
+
+// displayValue returns the text a cell displays: the column's Formatter applied to the
+// raw field value, or the default formatting. Sorting and filtering use the raw value.
+func (st *Table) displayValue(data interface{}, col ColumnConfig) string {
+	if col.Formatter != nil {
+		return col.Formatter(st.extractFieldRaw(data, col.ID))
 	}
-	if !field.IsValid() {
-		// Try uppercase (e.g., "id" -> "ID")
-		upper := strings.ToUpper(colID)
-		field = v.FieldByName(upper)
+	if value, ok := st.boolFieldValue(data, col); ok {
+		return boolDisplayText(value, st.boolDisplay(col))
 	}
-	if !field.IsValid() {
-		// Try case-insensitive search through all fields
-		t := v.Type()
-		for i := 0; i < v.NumField(); i++ {
-			if strings.EqualFold(t.Field(i).Name, colID) {
-				field = v.Field(i)
-				break
-			}
-		}
+	return st.extractFieldValue(data, col.ID)
+}
+
+// cellDisplayText returns the text the default renderer shows for a cell: GetCellValue
+// for popup columns that set it, otherwise the field's display value
+func (st *Table) cellDisplayText(data interface{}, col ColumnConfig) string {
+	if col.PopupOptions != nil && col.GetCellValue != nil {
+		return col.GetCellValue(data)
+	}
+	return st.displayValue(data, col)
+}
+
+// showsPopupIcon reports whether the default renderer draws a dropdown icon in col's cells
+func (st *Table) showsPopupIcon(col ColumnConfig) bool {
+	return col.ShowPopupIcon && col.PopupOptions != nil && col.Renderer == nil
+}
+
+// boolFieldValue returns the column's field value if it is a bool
+func (st *Table) boolFieldValue(data interface{}, col ColumnConfig) (value bool, ok bool) {
+	v := reflect.ValueOf(st.extractFieldRaw(data, col.ID))
+	if v.Kind() != reflect.Bool {
+		return false, false
 	}
+	return v.Bool(), true
+}
 
-	if field.IsValid() {
-		return fmt.Sprintf("%v", field.Interface())
+// boolDisplay resolves the column's BoolDisplay, applying Config.BoolAsIcon to the default
+func (st *Table) boolDisplay(col ColumnConfig) BoolDisplay {
+	if col.BoolDisplay != BoolDisplayDefault {
+		return col.BoolDisplay
+	}
+	if st.config.BoolAsIcon {
+		return BoolDisplayIcon
 	}
+	return BoolDisplayText
+}
 
-	// Fallback to string representation
-	return fmt.Sprintf("%v", data)
+// boolDisplayText returns the text shown for a bool value in the given display style
+func boolDisplayText(value bool, display BoolDisplay) string {
+	trueText, falseText := "true", "false"
+	switch display {
+	case BoolDisplayIcon:
+		trueText, falseText = "✓", "✗"
+	case BoolDisplayYesNo:
+		trueText, falseText = "Yes", "No"
+	case BoolDisplayOnOff:
+		trueText, falseText = "On", "Off"
+	}
+	if value {
+		return trueText
+	}
+	return falseText
 }
 
 // findColumnDividerAtPosition detects if a position is near a column divider
 func (st *Table) findColumnDividerAtPosition(pos fyne.Position) int {
 	// Only auto-resize if in the header row area
-	headerHeight := st.config.HeaderHeight
-	if headerHeight == 0 {
-		headerHeight = 30.0
-	}
+	headerHeight := st.config.headerHeight()
 
 	if pos.Y > headerHeight {
 		return -1 // Not in header area
@@ -1370,28 +3521,170 @@ func (st *Table) findColumnDividerAtPosition(pos fyne.Position) int {
 	// don't update our config
 	st.syncColumnWidthsFromTable()
 
-	// Calculate cumulative widths to find which divider was clicked
-	// Only iterate through visible columns
+	return st.columnDividerAt(pos.X)
+}
+
+// columnDividerAt returns the actual index of the column whose right edge is within
+// the divider tolerance of x, or -1
+func (st *Table) columnDividerAt(x float32) int {
 	threshold := float32(10.0) // 10px tolerance on each side of divider for easier targeting
-	xPos := float32(0)
 
-	for _, actualIdx := range st.state.visibleColumns {
-		col := st.config.Columns[actualIdx]
-		colWidth := col.Width
+	for i, xPos := range st.columnDividerOffsets() {
+		distance := math.Abs(float64(x - xPos))
+
+		// Check if position is near this column's right edge
+		if distance <= float64(threshold) {
+			return st.state.visibleColumns[i] // Return actual column index
+		}
+	}
+
+	return -1
+}
+
+// columnDividerOffsets returns the x position of each visible column's right-hand
+// divider relative to the table, laid out like widget.Table (see CellBounds): the
+// configured widths separated by theme padding, shifted by the horizontal scroll
+func (st *Table) columnDividerOffsets() []float32 {
+	offsets := make([]float32, 0, len(st.state.visibleColumns))
+	padding := theme.Padding()
+	xPos := -st.scrollOffset().X
+	for i, actualIdx := range st.state.visibleColumns {
+		if i > 0 {
+			xPos += padding
+		}
+		colWidth := st.config.Columns[actualIdx].Width
 		if colWidth == 0 {
 			colWidth = 100 // Default width
 		}
 		xPos += colWidth
+		offsets = append(offsets, xPos+padding/2) // Fyne draws the divider mid-gap
+	}
+	return offsets
+}
+
+// hookTableScroll chains onto the table widget's scroll container so the resize strip
+// and the detail overlay follow user scrolling. Fyne creates the container with the
+// table's renderer (and again if the renderer is rebuilt), so this is re-checked on
+// cell updates.
+func (st *Table) hookTableScroll() {
+	if (st.resizeStrip == nil && st.detailOverlay == nil) || st.table == nil {
+		return
+	}
+	v := st.tableInternal("content")
+	if !v.IsValid() {
+		return
+	}
+	content, _ := v.Interface().(*container.Scroll)
+	if content == nil || content == st.hookedScroll {
+		return
+	}
+	st.hookedScroll = content
+	baseOnScrolled := content.OnScrolled
+	content.OnScrolled = func(pos fyne.Position) {
+		if baseOnScrolled != nil {
+			baseOnScrolled(pos)
+		}
+		st.handleTableScrolled()
+	}
+}
 
-		distance := math.Abs(float64(pos.X - xPos))
+// handleTableScrolled re-lays out the resize strip and the detail overlay so they
+// follow the scrolled rows and columns
+func (st *Table) handleTableScrolled() {
+	if st.resizeStrip != nil {
+		st.resizeStrip.Refresh()
+	}
+	st.refreshDetailOverlay()
+}
 
-		// Check if position is near this column's right edge
-		if distance <= float64(threshold) {
-			return actualIdx // Return actual column index
+// scrollOffset returns the table widget's current scroll offset
+func (st *Table) scrollOffset() fyne.Position {
+	var offset fyne.Position
+	if st.table == nil {
+		return offset
+	}
+	if v := st.tableInternal("offset"); v.IsValid() {
+		offset, _ = v.Interface().(fyne.Position)
+	}
+	return offset
+}
+
+// tableInternal returns an unexported field of the underlying widget.Table for
+// reading, or an invalid Value if the field doesn't exist in this Fyne version
+func (st *Table) tableInternal(name string) reflect.Value {
+	field := reflect.ValueOf(st.table.Table).Elem().FieldByName(name)
+	if !field.IsValid() {
+		return field
+	}
+	// Use unsafe to access unexported field
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
+
+// CellBounds returns the position and size of a cell relative to the table widget's
+// top-left corner, accounting for the current scroll offset. row is a data index and
+// col a column index (as used by SetSelectedCell). ok is false if the cell is not
+// currently shown (filtered out, in a collapsed node or group, or in a hidden column)
+// or the table has not been created. For canvas coordinates, add
+// fyne.CurrentApp().Driver().AbsolutePositionForObject(table).
+//
+// Cells scrolled out of view return positions outside the table's size.
+func (st *Table) CellBounds(row, col int) (pos fyne.Position, size fyne.Size, ok bool) {
+	if st.table == nil {
+		return fyne.Position{}, fyne.Size{}, false
+	}
+
+	st.mu.RLock()
+	displayRow := indexOf(st.state.visibleRows, row)
+	displayCol := indexOf(st.state.visibleColumns, col)
+	st.mu.RUnlock()
+	if row < 0 || col < 0 || displayRow < 0 || displayCol < 0 {
+		return fyne.Position{}, fyne.Size{}, false
+	}
+
+	// Layout values from the table widget; before the first render fall back to the config
+	cellSize := fyne.NewSize(100, st.config.rowHeight())
+	if v := st.tableInternal("cellSize"); v.IsValid() {
+		if size, isSize := v.Interface().(fyne.Size); isSize && !size.IsZero() {
+			cellSize = size
+		}
+	}
+	columnWidths, _ := st.tableInternal("columnWidths").Interface().(map[int]float32)
+	rowHeights, _ := st.tableInternal("rowHeights").Interface().(map[int]float32)
+	offset := st.scrollOffset()
+
+	// Same cumulative layout as widget.Table: cells separated by theme padding
+	padding := theme.Padding()
+	cellX, cellWidth := float32(0), float32(0)
+	for i := 0; i <= displayCol; i++ {
+		if i > 0 {
+			cellX += cellWidth + padding
+		}
+		cellWidth = cellSize.Width
+		if w, found := columnWidths[i]; found {
+			cellWidth = w
+		}
+	}
+	cellY, cellHeight := float32(0), float32(0)
+	for i := 0; i <= displayRow+1; i++ { // Table row 0 is the column header row
+		if i > 0 {
+			cellY += cellHeight + padding
+		}
+		cellHeight = cellSize.Height
+		if h, found := rowHeights[i]; found {
+			cellHeight = h
 		}
 	}
 
-	return -1
+	// Fyne's own header row sits above the cells when shown
+	if st.table.ShowHeaderRow {
+		if v := st.tableInternal("headerSize"); v.IsValid() {
+			if headerSize, isSize := v.Interface().(fyne.Size); isSize {
+				cellY += headerSize.Height
+			}
+		}
+	}
+
+	return fyne.NewPos(cellX-offset.X, cellY-offset.Y), fyne.NewSize(cellWidth, cellHeight), true
 }
 
 // syncColumnWidthsFromTable syncs config widths with actual table widths
@@ -1403,17 +3696,13 @@ func (st *Table) syncColumnWidthsFromTable() {
 	}
 
 	// Use reflection to access the internal columnWidths map in widget.Table
-	tableValue := reflect.ValueOf(st.table).Elem()
-	columnWidthsField := tableValue.FieldByName("columnWidths")
+	columnWidthsField := st.tableInternal("columnWidths")
 
 	if !columnWidthsField.IsValid() {
 		st.logger().Warn("Cannot access table columnWidths field")
 		return
 	}
 
-	// Use unsafe to access unexported field
-	columnWidthsField = reflect.NewAt(columnWidthsField.Type(), unsafe.Pointer(columnWidthsField.UnsafeAddr())).Elem()
-
 	// columnWidths is map[int]float32
 	columnWidthsMap, ok := columnWidthsField.Interface().(map[int]float32)
 	if !ok {
@@ -1435,72 +3724,42 @@ func (st *Table) syncColumnWidthsFromTable() {
 // autoResizeColumn calculates and applies the optimal width for a column
 func (st *Table) autoResizeColumn(colIndex int) {
 	if colIndex < 0 || colIndex >= len(st.config.Columns) {
-		st.logger().Error(fmt.Sprintf("Invalid column index: %d (total columns: %d)", colIndex, len(st.config.Columns)))
+		st.logger().Error("Invalid column index", "col", colIndex, "totalColumns", len(st.config.Columns))
 		return
 	}
 
 	col := st.config.Columns[colIndex]
 	maxWidth := float32(0)
+	measure := newTextWidthCache()
 
 	// Measure header width
-	headerText := col.Title
-	if st.state.sortColumn == colIndex {
-		headerText += " ▲" // Account for sort indicator
+	headerWidth := measure.width(st.headerText(col, colIndex), true)
+	if st.sortIndicatorIcon(colIndex) != nil {
+		headerWidth += theme.IconInlineSize() + theme.Padding() // Account for sort icon
 	}
-	headerWidth := st.measureTextWidth(headerText, true)
 	if headerWidth > maxWidth {
 		maxWidth = headerWidth
 	}
 
-	// Measure all data cells in this column
+	// Collect cell texts plus any tree decoration width, then measure only the
+	// cells that look widest (measuring every cell is too slow on large tables)
+	cells := make([]cellText, 0, len(st.data))
 	for i := range st.data {
-		cellText := st.extractFieldValue(st.data[i], col.ID)
-		cellWidth := st.measureTextWidth(cellText, false)
-
-		// For columns with custom renderers (like hierarchical Task Name),
-		// we need to account for additional visual elements
-		if col.Renderer != nil && col.ID == "name" {
-			// Check if this is hierarchical data with a Depth field
-			v := reflect.ValueOf(st.data[i])
-			if v.Kind() == reflect.Ptr {
-				v = v.Elem()
-			}
-			if v.Kind() == reflect.Struct {
-				depthField := v.FieldByName("Depth")
-				if depthField.IsValid() && depthField.Kind() == reflect.Int {
-					depth := int(depthField.Int())
-					if depth > 0 {
-						// Add indentation width based on config
-						var indentWidth float32
-						if st.config.ShowIndentation {
-							indentPerLevel := st.config.IndentPerLevel
-							if indentPerLevel == 0 {
-								indentPerLevel = 20.0 // Default if not set
-							}
-							indentWidth = float32(5 + (depth * int(indentPerLevel)))
-						}
-
-						// Add tree icon width if icons are enabled
-						var iconWidth float32
-						if st.config.ShowIndentIcons {
-							// Measure the actual icon text
-							iconText := ""
-							if st.config.ShowBranch {
-								iconText = st.config.TreeIconTheme.Branch
-							}
-							if len(st.config.TreeIconTheme.Icons) > 0 {
-								iconIndex := (depth - 1) % len(st.config.TreeIconTheme.Icons)
-								iconText += st.config.TreeIconTheme.Icons[iconIndex]
-							}
-							iconWidth = st.measureTextWidth(iconText, false)
-						}
-
-						cellWidth += indentWidth + iconWidth
-					}
-				}
-			}
+		if st.isFullWidthRow(i) {
+			continue // Shows its own widget instead of the column's value
+		}
+		cell := cellText{
+			text:  st.cellDisplayText(st.data[i], col),
+			extra: st.treeDecorationWidth(col, st.data[i], measure),
 		}
+		if st.showsPopupIcon(col) {
+			cell.extra += theme.IconInlineSize() // Dropdown icon
+		}
+		cells = append(cells, cell)
+	}
 
+	for _, cell := range sampleWidestCells(cells, measure.width("0", false), autoResizeSampleSize) {
+		cellWidth := measure.width(cell.text, false) + cell.extra
 		if cellWidth > maxWidth {
 			maxWidth = cellWidth
 		}
@@ -1533,11 +3792,50 @@ func (st *Table) autoResizeColumn(colIndex int) {
 		st.table.Resize(currentSize)
 
 		// Refresh both layers
-		st.table.Refresh()
-		st.table.Refresh()
+		st.refreshTable()
+		st.refreshTable()
+	}
+
+	st.notifyColumnsResized([]int{colIndex})
+}
+
+// handleHeaderDragEnd picks up columns resized by dragging in the header row.
+// Fyne only records drag resizes internally, so the widths are synced back and
+// compared with the configured ones.
+func (st *Table) handleHeaderDragEnd() {
+	previous := make([]float32, len(st.config.Columns))
+	for i, col := range st.config.Columns {
+		previous[i] = col.Width
+	}
+
+	st.syncColumnWidthsFromTable()
+
+	var resized []int
+	for i, col := range st.config.Columns {
+		if col.Width != previous[i] {
+			resized = append(resized, i)
+		}
+	}
+	if len(resized) > 0 {
+		st.notifyColumnsResized(resized)
 	}
+}
+
+// notifyColumnsResized fires Config.OnColumnResized for each resized column and
+// saves the column widths
+func (st *Table) notifyColumnsResized(colIndexes []int) {
+	if st.config.OnColumnResized != nil {
+		for _, colIndex := range colIndexes {
+			col := st.config.Columns[colIndex]
+			st.config.OnColumnResized(col.ID, col.Width)
+		}
+	}
+	st.saveColumnWidths()
+	st.refreshDetailOverlay() // Details span the resized columns
+}
 
-	// Save column widths if callback provided
+// saveColumnWidths passes the current column widths to Config.SaveColumnWidths, if set
+func (st *Table) saveColumnWidths() {
 	if st.config.SaveColumnWidths != nil {
 		widths := make(map[string]float32)
 		for idx, c := range st.config.Columns {
@@ -1547,15 +3845,149 @@ func (st *Table) autoResizeColumn(colIndex int) {
 	}
 }
 
-// measureTextWidth estimates the width needed for text
-func (st *Table) measureTextWidth(text string, bold bool) float32 {
-	// Create a temporary text object to measure size
-	tempText := canvas.NewText(text, theme.Color(theme.ColorNameForeground))
-	if bold {
-		tempText.TextStyle = fyne.TextStyle{Bold: true}
+// treeDecorationWidth returns the width of indentation and tree icons drawn before
+// a cell's text. For columns with custom renderers (like hierarchical Task Name),
+// we need to account for additional visual elements.
+func (st *Table) treeDecorationWidth(col ColumnConfig, item interface{}, measure textWidthCache) float32 {
+	if col.Renderer == nil && st.isTreeColumn(col) {
+		return st.treePrefixWidth(item, measure)
+	}
+	if col.Renderer == nil || col.ID != "name" {
+		return 0
+	}
+
+	// Check if this is hierarchical data with a Depth field
+	v := reflect.ValueOf(item)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+	depthField := v.FieldByName("Depth")
+	if !depthField.IsValid() || depthField.Kind() != reflect.Int {
+		return 0
+	}
+	depth := int(depthField.Int())
+	if depth <= 0 {
+		return 0
+	}
+
+	// Add indentation width based on config
+	var indentWidth float32
+	if st.config.ShowIndentation {
+		indentPerLevel := st.config.IndentPerLevel
+		if indentPerLevel == 0 {
+			indentPerLevel = 20.0 // Default if not set
+		}
+		indentWidth = float32(5 + (depth * int(indentPerLevel)))
+	}
+
+	// Add tree icon width if icons are enabled
+	var iconWidth float32
+	if st.config.ShowIndentIcons {
+		// Measure the actual icon text
+		iconWidth = measure.width(st.treeIconText(depth), false)
+	}
+
+	return indentWidth + iconWidth
+}
+
+// treePrefixWidth returns the width of the prefix wrapTreeCell draws before a tree
+// column cell's content: indentation, then the expand toggle or the tree icon
+func (st *Table) treePrefixWidth(item interface{}, measure textWidthCache) float32 {
+	depth := 0
+	if st.config.GetNodeDepth != nil {
+		depth = st.config.GetNodeDepth(item)
+	}
+
+	var width float32
+	items := 0
+	if st.config.ShowIndentation && depth > 0 {
+		indentPerLevel := st.config.IndentPerLevel
+		if indentPerLevel == 0 {
+			indentPerLevel = 20.0 // Default if not set
+		}
+		width += float32(depth) * indentPerLevel
+		items++
+	}
+	innerPadding := theme.InnerPadding()
+	if st.config.IsNodeExpandable != nil && st.config.GetNodeID != nil && st.config.IsNodeExpandable(item) {
+		width += measure.width("▶", false) + innerPadding*2 // Button text plus its padding
+		items++
+	} else if st.config.ShowIndentIcons && depth > 0 {
+		width += measure.width(st.treeIconText(depth), false) + innerPadding*2 // Label text plus its padding
+		items++
+	}
+	if items == 0 {
+		return 0
+	}
+	// HBox spacing between prefix items, and the border layout's gap before the content
+	return width + float32(items)*theme.Padding()
+}
+
+// autoResizeSampleSize is how many of the widest-looking cells autoResizeColumn measures exactly
+const autoResizeSampleSize = 50
+
+// cellText is a cell's text plus any fixed-width decoration drawn next to it
+type cellText struct {
+	text  string
+	extra float32
+}
+
+// sampleWidestCells returns the n cells with the largest estimated width, where the
+// estimate is rune count times charWidth plus decoration. Returns all cells if n or fewer.
+func sampleWidestCells(cells []cellText, charWidth float32, n int) []cellText {
+	if len(cells) <= n {
+		return cells
+	}
+
+	estimates := make([]float32, len(cells))
+	order := make([]int, len(cells))
+	for i, cell := range cells {
+		estimates[i] = float32(utf8.RuneCountInString(cell.text))*charWidth + cell.extra
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return estimates[order[a]] > estimates[order[b]]
+	})
+
+	sample := make([]cellText, n)
+	for i := range sample {
+		sample[i] = cells[order[i]]
+	}
+	return sample
+}
+
+// textMeasureKey identifies a measured string
+type textMeasureKey struct {
+	text string
+	bold bool
+}
+
+// textWidthCache memoizes theme text widths for the duration of an auto-resize pass
+type textWidthCache struct {
+	size   float32
+	widths map[textMeasureKey]float32
+}
+
+// newTextWidthCache creates a cache for the current theme text size
+func newTextWidthCache() textWidthCache {
+	return textWidthCache{
+		size:   theme.TextSize(),
+		widths: make(map[textMeasureKey]float32),
+	}
+}
+
+// width returns the rendered width of text at the theme text size
+func (c textWidthCache) width(text string, bold bool) float32 {
+	key := textMeasureKey{text: text, bold: bold}
+	if w, ok := c.widths[key]; ok {
+		return w
 	}
-	tempText.TextSize = theme.TextSize()
-	return tempText.MinSize().Width
+	w := fyne.MeasureText(text, c.size, fyne.TextStyle{Bold: bold}).Width
+	c.widths[key] = w
+	return w
 }
 
 // showPopupMenu displays a transient popup menu for cell options activated by SPACE key
@@ -1588,42 +4020,29 @@ func (st *Table) showPopupMenu(rowIndex, colIndex int, options []string, dataIte
 		}
 
 		menuItems = append(menuItems, fyne.NewMenuItem(displayText, func() {
-			st.logger().Info(fmt.Sprintf("[POPUP-CALLBACK] Menu item clicked: %s, params: rowIndex=%d colIndex=%d", optionVal, rowIndex, colIndex))
-			st.logger().Info(fmt.Sprintf("[POPUP-CALLBACK] Current state BEFORE callback: selectedRow=%d selectedCol=%d", st.state.selectedRow, st.state.selectedCol))
+			st.logger().Debug("[POPUP-CALLBACK] Menu item clicked", "option", optionVal, "row", rowIndex, "col", colIndex)
+			st.logger().Debug("[POPUP-CALLBACK] State before callback", "selectedRow", st.state.selectedRow, "selectedCol", st.state.selectedCol)
 
 			// Call the callback to get new value
 			newValue := col.OnPopupSelected(dataItem, optionVal, rowIndex)
 
-			st.logger().Info(fmt.Sprintf("[POPUP-CALLBACK] After OnPopupSelected, state: selectedRow=%d selectedCol=%d", st.state.selectedRow, st.state.selectedCol))
+			st.logger().Debug("[POPUP-CALLBACK] State after OnPopupSelected", "selectedRow", st.state.selectedRow, "selectedCol", st.state.selectedCol)
 
 			// Trigger OnCellEdited callback if defined
 			if st.config.OnCellEdited != nil {
 				st.config.OnCellEdited(rowIndex, col.ID, newValue, dataItem)
 			}
 
-			st.logger().Info(fmt.Sprintf("[POPUP-CALLBACK] After OnCellEdited, state: selectedRow=%d selectedCol=%d", st.state.selectedRow, st.state.selectedCol))
+			st.logger().Debug("[POPUP-CALLBACK] State after OnCellEdited", "selectedRow", st.state.selectedRow, "selectedCol", st.state.selectedCol)
 
 			// CRITICAL: Restore navigation state to the cell where popup was triggered
 			// The popup dismissal or callbacks may have triggered OnSelected which changed our state
 			// But we want arrow keys to continue from where the popup was originally shown
-			st.logger().Info(fmt.Sprintf("[POPUP-CALLBACK] Restoring navigation state from (%d,%d) to (%d,%d)",
-				st.state.selectedRow, st.state.selectedCol, rowIndex, colIndex))
-			st.state.selectedRow = rowIndex
-			st.state.selectedCol = colIndex
-
-			// Refresh the entire table to show updated value
-			// Note: We refresh the whole table instead of just the cell to avoid
-			// unintended side effects from RefreshItem (which can cause selection changes)
-			// We DON'T call Select() because that would trigger OnSelected callbacks
-			// and potentially change our navigation state. Our selectedRow/selectedCol
-			// remain unchanged, so arrow keys will work correctly from the current position.
-			if st.table != nil {
-				st.logger().Info(fmt.Sprintf("[REFRESH] Refreshing table after dropdown change, navigation state: row=%d col=%d", st.state.selectedRow, st.state.selectedCol))
-				st.table.Refresh()
-				st.logger().Info(fmt.Sprintf("[REFRESH] Table refreshed, navigation state preserved: row=%d col=%d", st.state.selectedRow, st.state.selectedCol))
-			}
+			st.logger().Debug("[POPUP-CALLBACK] Restoring navigation state", "fromRow", st.state.selectedRow, "fromCol", st.state.selectedCol,
+				"row", rowIndex, "col", colIndex)
+			st.moveSelectionTo(rowIndex, colIndex)
 
-			st.logger().Info(fmt.Sprintf("Popup selection: %s for row %d, col %s", optionVal, rowIndex, col.ID))
+			st.logger().Info("Popup selection", "option", optionVal, "row", rowIndex, "column", col.ID)
 		}))
 	}
 
@@ -1643,46 +4062,29 @@ func (st *Table) showPopupMenu(rowIndex, colIndex int, options []string, dataIte
 		return
 	}
 
-	// Find display column index
-	displayColIndex := -1
-	for i, visCol := range st.state.visibleColumns {
-		if visCol == colIndex {
-			displayColIndex = i
-			break
-		}
-	}
-	if displayColIndex < 0 {
-		st.logger().Warn(fmt.Sprintf("Cannot show popup menu: column %d not visible", colIndex))
+	cellPos, cellSize, ok := st.CellBounds(rowIndex, colIndex)
+	if !ok {
+		st.logger().Warn("Cannot show popup menu: cell not visible", "row", rowIndex, "col", colIndex)
 		return
 	}
 
-	// Calculate cell position
-	// X position: sum of all column widths before this column
-	xPos := float32(0)
-	for i := 0; i < displayColIndex; i++ {
-		actualColIdx := st.state.visibleColumns[i]
-		if actualColIdx < len(st.config.Columns) {
-			xPos += st.config.Columns[actualColIdx].Width
-		}
-	}
-
-	// Calculate Y position: header height + (row * row height)
-	yPos := st.config.HeaderHeight + (float32(rowIndex) * st.config.RowHeight)
-
 	// Get table's absolute position on canvas for correct popup placement
 	tablePos := fyne.CurrentApp().Driver().AbsolutePositionForObject(st.table)
 
-	// Position popup at bottom of the cell (yPos is top of cell, add rowHeight for bottom)
-	popupYPos := tablePos.Y + yPos + st.config.RowHeight
-	popupXPos := tablePos.X + xPos
+	// Position popup at bottom of the cell
+	popupYPos := tablePos.Y + cellPos.Y + cellSize.Height
+	popupXPos := tablePos.X + cellPos.X
 	pos := fyne.NewPos(popupXPos, popupYPos)
 
-	st.logger().Info(fmt.Sprintf("Showing popup at pos (%.1f, %.1f) for cell row=%d col=%d (tablePos=%v, cellTop=%.1f)", popupXPos, popupYPos, rowIndex, colIndex, tablePos, yPos))
+	st.logger().Debug("[POPUP] Showing popup", "x", popupXPos, "y", popupYPos, "row", rowIndex, "col", colIndex,
+		"tablePos", tablePos, "cellTop", cellPos.Y)
 
 	widget.ShowPopUpMenuAtPosition(popupMenu, canvas, pos)
 }
 
-// RenderTextWithPopupIcon renders a text cell with an optional dropdown indicator icon
+// RenderTextWithPopupIcon renders a text cell with an optional dropdown indicator icon.
+// The default renderer already draws the icon for columns with ShowPopupIcon; use this
+// from custom renderers.
 // This is a helper function for columns that have popup menus
 func RenderTextWithPopupIcon(text string, showIcon bool, alignment TextAlignment) *fyne.Container {
 	textLabel := widget.NewLabel(text)
@@ -1,9 +1,23 @@
 package table
 
 import (
+	"context"
 	"fmt"
+	"image/color"
+	"log/slog"
+	"slices"
 	"strings"
 	"testing"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/data/binding"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
+	"fyne.io/fyne/v2/widget"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
 )
 
 // TestData represents a simple test struct with various fields
@@ -165,6 +179,188 @@ func TestNewTableWithCustomLogger(t *testing.T) {
 	}
 }
 
+// captureHandler is a slog.Handler that records every log record
+type captureHandler struct {
+	records []slog.Record
+}
+
+func (h *captureHandler) Enabled(context.Context, slog.Level) bool { return true }
+func (h *captureHandler) Handle(_ context.Context, r slog.Record) error {
+	h.records = append(h.records, r)
+	return nil
+}
+func (h *captureHandler) WithAttrs([]slog.Attr) slog.Handler { return h }
+func (h *captureHandler) WithGroup(string) slog.Handler      { return h }
+
+func TestSlogLogger(t *testing.T) {
+	handler := &captureHandler{}
+	logger := NewSlogLogger(slog.New(handler))
+
+	logger.Debug("debug msg", "row", 3)
+	logger.Info("info msg", "col", "name", "asc", true)
+	logger.Warn("warn msg", 42, "answer", slog.Int("n", 1), "dangling")
+	logger.Error("error msg")
+
+	wantLevels := []slog.Level{slog.LevelDebug, slog.LevelInfo, slog.LevelWarn, slog.LevelError}
+	wantAttrs := []string{"row=3", "col=name asc=true", "42=answer n=1 !BADKEY=dangling", ""}
+	if len(handler.records) != len(wantLevels) {
+		t.Fatalf("Expected %d records, got %d", len(wantLevels), len(handler.records))
+	}
+	for i, r := range handler.records {
+		if r.Level != wantLevels[i] {
+			t.Errorf("Record %d (%q): expected level %v, got %v", i, r.Message, wantLevels[i], r.Level)
+		}
+		var attrs []string
+		r.Attrs(func(a slog.Attr) bool {
+			attrs = append(attrs, a.String())
+			return true
+		})
+		if got := strings.Join(attrs, " "); got != wantAttrs[i] {
+			t.Errorf("Record %d (%q): expected attrs %q, got %q", i, r.Message, wantAttrs[i], got)
+		}
+	}
+}
+
+func TestTableLogsWithAttrs(t *testing.T) {
+	handler := &captureHandler{}
+	config := createTestConfig()
+	config.Columns[1].Sortable = true
+	config.Logger = NewSlogLogger(slog.New(handler))
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.Sort("name", false)
+	table.SetFilter("a", false)
+
+	// The table's own records carry their values as attributes, not in the message
+	attrsOf := func(message string) string {
+		for _, r := range handler.records {
+			if r.Message == message {
+				var attrs []string
+				r.Attrs(func(a slog.Attr) bool {
+					attrs = append(attrs, a.String())
+					return true
+				})
+				return strings.Join(attrs, " ")
+			}
+		}
+		t.Fatalf("Expected a %q record", message)
+		return ""
+	}
+	if got := attrsOf("[SORT] sortData key"); got != "priority=1 column=name title=Name asc=false rows=5" {
+		t.Errorf("Unexpected sort key attrs %q", got)
+	}
+	if got := attrsOf("Filter set"); !strings.HasPrefix(got, "text=a regex=false caseSensitive=false visibleRows=") {
+		t.Errorf("Unexpected filter attrs %q", got)
+	}
+}
+
+func TestLogLevelFiltersMessages(t *testing.T) {
+	config := createTestConfig()
+	logger := &TestLogger{}
+	config.Logger = logger
+	table := createTestTable(config)
+
+	logAll := func() {
+		table.logger().Debug("d")
+		table.logger().Info("i")
+		table.logger().Warn("w")
+		table.logger().Error("e")
+	}
+
+	// Default level passes everything through
+	logAll()
+	if len(logger.logs) != 4 {
+		t.Errorf("Expected 4 messages at default level, got %v", logger.logs)
+	}
+
+	config.LogLevel = LogLevelWarn
+	logger.logs = nil
+	logAll()
+	if strings.Join(logger.logs, ",") != "WARN: w,ERROR: e" {
+		t.Errorf("Expected only Warn/Error, got %v", logger.logs)
+	}
+
+	// Routine operations like sorting log at Debug, so Info is quiet
+	config.LogLevel = LogLevelInfo
+	config.Columns[1].Sortable = true
+	logger.logs = nil
+	table.SetData(createTestData())
+	table.Sort("name", true)
+	if len(logger.logs) != 0 {
+		t.Errorf("Expected no messages at Info level, got %v", logger.logs)
+	}
+}
+
+func TestNewTableReportsInvalidConfig(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := NewConfig("empty")
+	logger := &TestLogger{}
+	config.Logger = logger
+
+	// NewTableChecked returns the validation error
+	table, err := NewTableChecked(config)
+	if err == nil || table != nil {
+		t.Fatalf("Expected validation error for config without columns, got table=%v err=%v", table, err)
+	}
+	if !strings.Contains(err.Error(), "at least one column is required") {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// NewTable still builds the table but logs the problem
+	if NewTable(config) == nil {
+		t.Fatal("Expected NewTable to return a table")
+	}
+	found := false
+	for _, log := range logger.logs {
+		if strings.HasPrefix(log, "ERROR: ") && strings.Contains(log, "at least one column is required") {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected an error to be logged, got %v", logger.logs)
+	}
+
+	// A valid config passes
+	if _, err := NewTableChecked(createTestConfig()); err != nil {
+		t.Errorf("Expected valid config to succeed, got %v", err)
+	}
+}
+
+func TestConfigClone(t *testing.T) {
+	base := createTestConfig()
+	base.ExpandedNodes["root"] = true
+	var edited int
+	base.OnCellEdited = func(rowIndex int, colID string, newValue string, data interface{}) { edited++ }
+
+	clone := base.Clone()
+	clone.Columns[0].Title = "Identifier"
+	clone.Columns[1].Hidden = true
+	clone.Columns = append(clone.Columns, ColumnConfig{ID: "extra"})
+	clone.FilterColumns[0] = "name"
+	clone.ExpandedNodes["root"] = false
+	clone.ID = "clone"
+
+	if base.Columns[0].Title != "ID" || base.Columns[1].Hidden || len(base.Columns) != 4 {
+		t.Errorf("Original columns changed by clone: %+v", base.Columns)
+	}
+	if base.FilterColumns[0] != "id" {
+		t.Errorf("Original FilterColumns changed: %v", base.FilterColumns)
+	}
+	if !base.ExpandedNodes["root"] {
+		t.Error("Original ExpandedNodes changed by clone")
+	}
+	if base.ID != "test-table" {
+		t.Errorf("Original ID changed: %q", base.ID)
+	}
+
+	// Callbacks are shared
+	clone.OnCellEdited(0, "id", "x", nil)
+	if edited != 1 {
+		t.Error("Expected clone to share OnCellEdited callback")
+	}
+}
+
 // ========== Test: SetData / GetData ==========
 
 func TestSetDataEmpty(t *testing.T) {
@@ -272,6 +468,358 @@ func TestSetDataPreservesSortOrder(t *testing.T) {
 	}
 }
 
+// TestSetDataConcurrentWithRender replaces data from a goroutine while cells render.
+// Run with -race to verify data and row state are guarded.
+func TestSetDataConcurrentWithRender(t *testing.T) {
+	test.NewTempApp(t)
+
+	var table *Table
+	config := createTestConfig()
+	config.Columns[2].Renderer = func(data interface{}, cell fyne.CanvasObject, rowIndex int, colID string) {
+		_ = len(table.GetData()) // Renderers run without the data lock, so calling back must not deadlock
+	}
+	// Callbacks of the default renderer too
+	config.Columns[1].Formatter = func(value interface{}) string {
+		_ = len(table.GetData())
+		return fmt.Sprint(value)
+	}
+	config.IsRowSelectable = func(data interface{}) bool {
+		return len(table.GetData()) > 0
+	}
+	table = createTestTable(config)
+	table.SetData(createTestData())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			data := createTestData()
+			table.SetData(data[:1+i%len(data)])
+		}
+	}()
+
+	cell := table.tableCreateCell()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		rows, cols := table.tableLength()
+		for row := 1; row < rows; row++ {
+			for col := 0; col < cols; col++ {
+				table.tableUpdateCell(widget.TableCellID{Row: row, Col: col}, cell)
+			}
+		}
+		_ = len(table.GetData())
+	}
+}
+
+func TestLoadCSV(t *testing.T) {
+	config := NewConfig("csv")
+	config.Columns = []ColumnConfig{
+		{ID: "name", Title: "Name"},
+		{ID: "city", Title: "City"},
+	}
+	table := createTestTable(config)
+
+	// Header row names the keys
+	err := table.LoadCSV(strings.NewReader("city,name\nOslo,Carol\n\"Lima, Peru\",Bob\n"), true)
+	if err != nil {
+		t.Fatalf("LoadCSV failed: %v", err)
+	}
+	if table.GetTotalRowCount() != 2 {
+		t.Fatalf("Expected 2 rows, got %d", table.GetTotalRowCount())
+	}
+	if got := table.extractFieldValue(table.data[1], "city"); got != "Lima, Peru" {
+		t.Errorf("Expected 'Lima, Peru', got %q", got)
+	}
+
+	// Without a header, fields follow column order
+	if err := table.LoadCSV(strings.NewReader("Dana,Rome\n"), false); err != nil {
+		t.Fatalf("LoadCSV failed: %v", err)
+	}
+	if row := table.data[0].(map[string]string); row["name"] != "Dana" || row["city"] != "Rome" {
+		t.Errorf("Expected name=Dana city=Rome, got %v", row)
+	}
+
+	// Malformed input is rejected and keeps the current data
+	for _, input := range []string{
+		"Eve,Paris\nFrank\n",        // Inconsistent field count
+		"Eve,\"Paris\n",             // Unterminated quote
+		"Eve,Paris,France,Europe\n", // More fields than columns
+	} {
+		if err := table.LoadCSV(strings.NewReader(input), false); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+		if table.GetTotalRowCount() != 1 || table.data[0].(map[string]string)["name"] != "Dana" {
+			t.Errorf("Expected data unchanged after %q, got %v", input, table.data)
+		}
+	}
+}
+
+func TestSetDataIfChangedSkipsIdenticalData(t *testing.T) {
+	config := createTestConfig()
+	compares := 0
+	config.Columns[1].Sortable = true
+	config.Columns[1].Comparator = func(a, b interface{}) int {
+		compares++
+		return strings.Compare(a.(TestData).Name, b.(TestData).Name)
+	}
+	table := createTestTable(config)
+	data := createTestData()
+	table.SetData(data)
+	if err := table.Sort("name", true); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+
+	// The same slice (which sorting reordered in place) is not re-sorted
+	compares = 0
+	if table.SetDataIfChanged(data) {
+		t.Error("Expected SetDataIfChanged to skip the slice already set")
+	}
+	if table.SetDataIfChanged(table.GetData()) || compares != 0 {
+		t.Errorf("Expected no re-sort for identical data, got %d comparisons", compares)
+	}
+
+	// A different slice is set and sorted
+	if !table.SetDataIfChanged(createTestData()) || compares == 0 {
+		t.Error("Expected a new slice to be set and sorted")
+	}
+
+	// DataEqual recognises equal content in a new slice
+	config.DataEqual = func(a, b []interface{}) bool { return slices.Equal(a, b) }
+	compares = 0
+	if table.SetDataIfChanged(createTestData()) || compares != 0 {
+		t.Errorf("Expected DataEqual to skip equal data, got %d comparisons", compares)
+	}
+	changed := createTestData()
+	changed[0] = TestData{ID: 9, Name: "Zed"}
+	if !table.SetDataIfChanged(changed) || table.GetTotalRowCount() != 5 || compares == 0 {
+		t.Error("Expected changed data to be set")
+	}
+}
+
+// ========== Test: Sort State ==========
+
+func TestGetSortState(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[1].Sortable = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	if id, asc, sorted := table.GetSortState(); sorted || id != "" || !asc {
+		t.Errorf("Expected no sort initially, got (%q, %v, %v)", id, asc, sorted)
+	}
+
+	table.state.sortColumn = 1
+	table.state.sortAsc = false
+
+	id, asc, sorted := table.GetSortState()
+	if !sorted || id != "name" || asc {
+		t.Errorf("Expected (\"name\", false, true), got (%q, %v, %v)", id, asc, sorted)
+	}
+}
+
+func TestSortByColumnID(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[3].Sortable = true
+	config.Columns[3].Comparator = func(a, b interface{}) int {
+		return a.(TestData).Priority - b.(TestData).Priority
+	}
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.SetColumnFilterOp("status", FilterEquals, "Active") // Visible rows must follow the new order
+
+	if err := table.Sort("priority", false); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var priorities []int
+	for _, rowIdx := range table.state.visibleRows {
+		priorities = append(priorities, table.data[rowIdx].(TestData).Priority)
+	}
+	expected := []int{4, 2, 1} // Active rows: David, Charlie, Alice
+	if fmt.Sprint(priorities) != fmt.Sprint(expected) {
+		t.Errorf("Expected visible priorities %v, got %v", expected, priorities)
+	}
+	if id, asc, sorted := table.GetSortState(); !sorted || id != "priority" || asc {
+		t.Errorf("Expected (\"priority\", false, true), got (%q, %v, %v)", id, asc, sorted)
+	}
+}
+
+func TestClearSortRestoresOriginalOrder(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[1].Sortable = true
+	config.Columns[3].Sortable = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	names := func() []string {
+		var result []string
+		for _, rowIdx := range table.state.visibleRows {
+			result = append(result, table.data[rowIdx].(TestData).Name)
+		}
+		return result
+	}
+	original := names()
+
+	if err := table.Sort("name", false); err != nil {
+		t.Fatalf("Sort: %v", err)
+	}
+	if err := table.Sort("priority", true); err != nil {
+		t.Fatalf("Sort: %v", err)
+	}
+	if slices.Equal(names(), original) {
+		t.Fatal("Expected sorting to change the order")
+	}
+
+	table.ClearSort()
+	if got := names(); !slices.Equal(got, original) {
+		t.Errorf("Expected original order %v after ClearSort, got %v", original, got)
+	}
+	if _, _, sorted := table.GetSortState(); sorted {
+		t.Error("Expected no sort state after ClearSort")
+	}
+	if text := table.sortIndicatorText(1); text != "" {
+		t.Errorf("Expected no header indicator after ClearSort, got %q", text)
+	}
+
+	// New data is remembered in the order it was set, even while sorted
+	table.Sort("name", true)
+	reversed := createTestData()
+	slices.Reverse(reversed)
+	table.SetData(reversed)
+	table.ClearSort()
+	if got := names(); !slices.Equal(got, []string{"David", "alice", "Charlie", "Bob", "Alice"}) {
+		t.Errorf("Expected the order of the latest SetData, got %v", got)
+	}
+}
+
+func TestSortRejectsInvalidColumn(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	data := createTestData()
+	table.SetData(data)
+	original := fmt.Sprint(table.data)
+
+	if err := table.Sort("name", true); err == nil {
+		t.Error("Expected error sorting non-sortable column")
+	}
+	if err := table.Sort("missing", true); err == nil {
+		t.Error("Expected error sorting unknown column")
+	}
+
+	if fmt.Sprint(table.data) != original {
+		t.Error("Expected data to be unchanged after rejected sort")
+	}
+	if _, _, sorted := table.GetSortState(); sorted {
+		t.Error("Expected no sort state after rejected sort")
+	}
+}
+
+func TestSortIsStable(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[2].Sortable = true
+	table := createTestTable(config)
+
+	// Many rows, three statuses: enough to leave the small-slice insertion sort
+	statuses := []string{"Active", "Inactive", "Pending"}
+	var data []interface{}
+	for i := range 60 {
+		data = append(data, TestData{ID: i, Name: fmt.Sprintf("Task %d", i), Status: statuses[(i*7)%3]})
+	}
+	table.SetData(data)
+
+	ids := func() []int {
+		var result []int
+		for _, item := range table.data {
+			result = append(result, item.(TestData).ID)
+		}
+		return result
+	}
+
+	table.Sort("status", true)
+	first := ids()
+	for i := 1; i < len(table.data); i++ {
+		prev, cur := table.data[i-1].(TestData), table.data[i].(TestData)
+		if prev.Status == cur.Status && prev.ID > cur.ID {
+			t.Fatalf("Expected ties in insertion order, got ID %d before %d in %s", prev.ID, cur.ID, cur.Status)
+		}
+	}
+
+	table.Sort("status", true)
+	if second := ids(); !slices.Equal(first, second) {
+		t.Errorf("Expected re-sorting to keep the order\nfirst:  %v\nsecond: %v", first, second)
+	}
+	table.SetData(data)
+	if third := ids(); !slices.Equal(first, third) {
+		t.Errorf("Expected SetData's re-sort to keep the order\nfirst: %v\nthird: %v", first, third)
+	}
+}
+
+func TestSetSortKeys(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[2].Sortable = true
+	config.Columns[3].Sortable = true
+	config.Columns[3].Comparator = NewNumericComparator("Priority")
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	names := func() []string {
+		var result []string
+		for _, rowIdx := range table.state.visibleRows {
+			result = append(result, table.data[rowIdx].(TestData).Name)
+		}
+		return result
+	}
+
+	// Status ascending, then priority descending within each status
+	table.SetSortKeys([]SortKey{
+		{ColumnID: "status", Ascending: true},
+		{ColumnID: "missing", Ascending: true}, // Skipped: unknown
+		{ColumnID: "name", Ascending: true},    // Skipped: not sortable
+		{ColumnID: "priority", Ascending: false},
+		{ColumnID: "status", Ascending: false}, // Skipped: repeat
+	})
+	want := []string{"David", "Charlie", "Alice", "Bob", "alice"}
+	if got := names(); !slices.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	wantKeys := SortSpec{{ColumnID: "status", Ascending: true}, {ColumnID: "priority", Ascending: false}}
+	if keys := table.GetSortKeys(); !slices.Equal(keys, wantKeys) {
+		t.Errorf("Expected sort keys %v, got %v", wantKeys, keys)
+	}
+	if id, asc, sorted := table.GetSortState(); !sorted || id != "status" || !asc {
+		t.Errorf("Expected primary (\"status\", true, true), got (%q, %v, %v)", id, asc, sorted)
+	}
+
+	// Secondary keys follow their columns and survive SetData
+	if err := table.SetColumnOrder([]string{"priority", "status", "name", "id"}); err != nil {
+		t.Fatalf("SetColumnOrder: %v", err)
+	}
+	table.SetData(createTestData())
+	if keys := table.GetSortKeys(); !slices.Equal(keys, wantKeys) {
+		t.Errorf("Expected sort keys %v after reordering columns, got %v", wantKeys, keys)
+	}
+	if got := names(); !slices.Equal(got, want) {
+		t.Errorf("Expected %v after SetData, got %v", want, got)
+	}
+
+	// Sort replaces all keys; no valid keys clears the sort
+	table.Sort("priority", true)
+	if keys := table.GetSortKeys(); len(keys) != 1 || keys[0].ColumnID != "priority" {
+		t.Errorf("Expected Sort to leave one key, got %v", keys)
+	}
+	table.SetSortKeys(nil)
+	if keys := table.GetSortKeys(); keys != nil || table.state.IsSorted() {
+		t.Errorf("Expected no sort keys, got %v", keys)
+	}
+	if got := names(); !slices.Equal(got, []string{"Alice", "Bob", "Charlie", "alice", "David"}) {
+		t.Errorf("Expected original order after clearing the sort, got %v", got)
+	}
+}
+
 // ========== Test: Filtering ==========
 
 func TestSetFilterPlainText(t *testing.T) {
@@ -384,6 +932,160 @@ func TestSetFilterRegex(t *testing.T) {
 	}
 }
 
+func TestSetFilterInvalidRegex(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	data := createTestData()
+	table.SetData(data)
+
+	if err := table.SetFilter("Active", false); err != nil {
+		t.Fatalf("Expected no error for plain filter, got %v", err)
+	}
+	previous := append([]int(nil), table.state.visibleRows...)
+
+	err := table.SetFilter("([a-z", true)
+	if err == nil {
+		t.Fatal("Expected error for invalid regex pattern")
+	}
+	if table.GetFilterError() != err {
+		t.Errorf("Expected GetFilterError to return %v, got %v", err, table.GetFilterError())
+	}
+
+	// Previous filter and visible rows are kept
+	if text, regex := table.GetFilter(); text != "Active" || regex {
+		t.Errorf("Expected previous filter (Active, false), got (%q, %v)", text, regex)
+	}
+	if len(table.state.visibleRows) != len(previous) {
+		t.Fatalf("Expected %d visible rows after invalid regex, got %d", len(previous), len(table.state.visibleRows))
+	}
+	for i := range previous {
+		if table.state.visibleRows[i] != previous[i] {
+			t.Errorf("Visible row %d changed: expected %d, got %d", i, previous[i], table.state.visibleRows[i])
+		}
+	}
+
+	// A valid filter clears the error
+	if err := table.SetFilter("^P", true); err != nil {
+		t.Fatalf("Expected no error for valid regex, got %v", err)
+	}
+	if table.GetFilterError() != nil {
+		t.Errorf("Expected filter error to be cleared, got %v", table.GetFilterError())
+	}
+}
+
+func TestSetColumnFilterOp(t *testing.T) {
+	tests := []struct {
+		name          string
+		columnID      string
+		op            FilterOperator
+		value         string
+		caseSensitive bool
+		expectedIDs   []int
+	}{
+		{"contains", "name", FilterContains, "li", false, []int{1, 3, 4}},
+		{"equals", "id", FilterEquals, "1", false, []int{1}},
+		{"equals case-insensitive", "name", FilterEquals, "alice", false, []int{1, 4}},
+		{"equals case-sensitive", "name", FilterEquals, "alice", true, []int{4}},
+		{"starts with", "status", FilterStartsWith, "in", false, []int{2}},
+		{"ends with", "name", FilterEndsWith, "e", false, []int{1, 3, 4}},
+		{"regex", "name", FilterRegex, "^(bob|david)$", false, []int{2, 5}},
+		{"regex case-sensitive", "name", FilterRegex, "^a", true, []int{4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := createTestTable(createTestConfig())
+			table.SetData(createTestData())
+			table.state.filterCaseSensitive = tt.caseSensitive
+
+			if err := table.SetColumnFilterOp(tt.columnID, tt.op, tt.value); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var ids []int
+			for _, rowIdx := range table.state.visibleRows {
+				ids = append(ids, table.data[rowIdx].(TestData).ID)
+			}
+			if len(ids) != len(tt.expectedIDs) {
+				t.Fatalf("Expected IDs %v, got %v", tt.expectedIDs, ids)
+			}
+			for i := range ids {
+				if ids[i] != tt.expectedIDs[i] {
+					t.Errorf("Expected IDs %v, got %v", tt.expectedIDs, ids)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestSetColumnFilterOpCombined(t *testing.T) {
+	table := createTestTable(createTestConfig())
+	table.SetData(createTestData())
+
+	// Exact match on one column combined with substring search on another
+	table.SetColumnFilterOp("status", FilterEquals, "active")
+	table.SetColumnFilterOp("name", FilterContains, "a")
+	if len(table.state.visibleRows) != 3 { // Alice, Charlie, David
+		t.Errorf("Expected 3 visible rows, got %d", len(table.state.visibleRows))
+	}
+
+	// Empty value removes the column filter
+	table.SetColumnFilterOp("name", FilterContains, "")
+	if len(table.state.visibleRows) != 3 { // Active rows
+		t.Errorf("Expected 3 visible rows after removing name filter, got %d", len(table.state.visibleRows))
+	}
+
+	// Invalid regex is rejected without changing visible rows
+	if err := table.SetColumnFilterOp("name", FilterRegex, "([a-z"); err == nil {
+		t.Error("Expected error for invalid regex column filter")
+	}
+	if len(table.state.visibleRows) != 3 {
+		t.Errorf("Expected visible rows unchanged after invalid regex, got %d", len(table.state.visibleRows))
+	}
+
+	table.ClearFilter()
+	if len(table.state.visibleRows) != 5 {
+		t.Errorf("Expected all 5 rows after ClearFilter, got %d", len(table.state.visibleRows))
+	}
+}
+
+func TestSetColumnFilter(t *testing.T) {
+	table := createTestTable(createTestConfig())
+	table.SetData(createTestData())
+
+	names := func() []string {
+		var result []string
+		for _, rowIdx := range table.state.visibleRows {
+			result = append(result, table.data[rowIdx].(TestData).Name)
+		}
+		return result
+	}
+	expect := func(step string, want ...string) {
+		t.Helper()
+		if got := names(); !slices.Equal(got, want) {
+			t.Errorf("%s: expected %v, got %v", step, want, got)
+		}
+	}
+
+	// Each column filter narrows the rows further
+	table.SetColumnFilter("status", "active") // Active and Inactive
+	expect("status", "Alice", "Bob", "Charlie", "David")
+	table.SetColumnFilter("name", "li")
+	expect("status and name", "Alice", "Charlie")
+
+	// The search filter is ANDed with the column filters
+	table.SetFilter("3", false) // Bob's ID/priority and Charlie's ID
+	expect("search and column filters", "Charlie")
+	table.SetFilter("", false)
+
+	// Clearing one column filter keeps the other
+	table.ClearColumnFilter("status")
+	expect("name only", "Alice", "Charlie", "alice")
+	table.SetColumnFilter("name", "")
+	expect("no filters", "Alice", "Bob", "Charlie", "alice", "David")
+}
+
 func TestClearFilter(t *testing.T) {
 	config := createTestConfig()
 	table := createTestTable(config)
@@ -425,27 +1127,297 @@ func TestSetFilterEmptyString(t *testing.T) {
 	}
 }
 
-// ========== Test: Selection ==========
-
-func TestSetSelectedCellValid(t *testing.T) {
+func TestSetRowFilter(t *testing.T) {
 	config := createTestConfig()
 	table := createTestTable(config)
-	data := createTestData()
-	table.SetData(data)
+	table.SetData(createTestData())
 
-	table.SetSelectedCell(2, 1)
+	// Numeric predicate: priority >= 2 (Bob=3, Charlie=2, David=4)
+	table.SetRowFilter(func(data interface{}) bool {
+		return data.(TestData).Priority >= 2
+	})
+	if got := table.GetVisibleRowCount(); got != 3 {
+		t.Fatalf("Expected 3 rows with priority >= 2, got %d", got)
+	}
+	if !table.state.HasFilter() {
+		t.Error("Expected HasFilter with a row filter")
+	}
 
-	row, col := table.GetSelectedCell()
-	if row != 2 || col != 1 {
-		t.Errorf("Expected selected cell (2, 1), got (%d, %d)", row, col)
+	// Combined with the text filter (AND): "li" matches Alice, Charlie and alice,
+	// of which only Charlie passes the predicate
+	table.SetFilter("li", false)
+	if got := table.GetVisibleRowCount(); got != 1 || table.data[table.state.visibleRows[0]].(TestData).Name != "Charlie" {
+		t.Errorf("Expected only Charlie, got rows %v", table.state.visibleRows)
 	}
-}
 
-func TestSetSelectedCellInvalidRow(t *testing.T) {
-	config := createTestConfig()
-	table := createTestTable(config)
-	data := createTestData()
-	table.SetData(data)
+	// The predicate survives SetData and ClearFilter
+	table.ClearFilter()
+	table.SetData(append(createTestData(), TestData{ID: 6, Name: "Eve", Priority: 9}))
+	if got := table.GetVisibleRowCount(); got != 4 {
+		t.Errorf("Expected 4 rows after SetData with predicate, got %d", got)
+	}
+	if got := table.GetTotalRowCount(); got != 6 {
+		t.Errorf("Expected total row count 6, got %d", got)
+	}
+
+	table.ClearRowFilter()
+	if got := table.GetVisibleRowCount(); got != 6 {
+		t.Errorf("Expected all 6 rows after ClearRowFilter, got %d", got)
+	}
+	if table.state.HasFilter() {
+		t.Error("Expected no active filter after ClearRowFilter")
+	}
+}
+
+func TestApplyFilterRerunsPredicate(t *testing.T) {
+	table := createTestTable(createTestConfig())
+	table.SetData(createTestData())
+	notified := 0
+	table.AddListener(binding.NewDataListener(func() { notified++ }))
+
+	minPriority := 2
+	table.SetRowFilter(func(data interface{}) bool {
+		return data.(TestData).Priority >= minPriority
+	})
+	if got := table.GetVisibleRowCount(); got != 3 { // Bob=3, Charlie=2, David=4
+		t.Fatalf("Expected 3 rows with priority >= 2, got %d", got)
+	}
+
+	// The predicate reads minPriority, but the rows only change when re-applied
+	minPriority = 3
+	if got := table.GetVisibleRowCount(); got != 3 {
+		t.Fatalf("Expected rows unchanged before ApplyFilter, got %d", got)
+	}
+	table.ApplyFilter()
+	if got := table.GetVisibleRowCount(); got != 2 {
+		t.Errorf("Expected 2 rows with priority >= 3 after ApplyFilter, got %d", got)
+	}
+	if notified != 2 {
+		t.Errorf("Expected 2 notifications (SetRowFilter, ApplyFilter), got %d", notified)
+	}
+}
+
+func TestSearchAllColumns(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	config.FilterColumns = nil
+	config.SearchAllColumns = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	// Matches values in any column
+	table.SetFilter("pending", false) // status
+	if got := table.GetVisibleRowCount(); got != 1 {
+		t.Errorf("Expected 1 row matching status, got %d", got)
+	}
+	table.SetFilter("4", false) // id of alice, priority of David
+	if got := table.GetVisibleRowCount(); got != 2 {
+		t.Errorf("Expected 2 rows matching id or priority, got %d", got)
+	}
+
+	// Hidden columns aren't searched
+	table.SetColumnVisibility("priority", false)
+	table.SetFilter("4", false)
+	if got := table.GetVisibleRowCount(); got != 1 {
+		t.Errorf("Expected only the id match with priority hidden, got %d", got)
+	}
+
+	// Without searchable columns the search box is disabled and explains why
+	config = createTestConfig()
+	config.FilterColumns = nil
+	config.ShowSearch = true
+	if entry := NewTable(config).filterEntry; !entry.Disabled() || !strings.Contains(entry.PlaceHolder, "no searchable columns") {
+		t.Errorf("Expected disabled search box with explanation, got disabled=%v placeholder=%q", entry.Disabled(), entry.PlaceHolder)
+	}
+	config.SearchAllColumns = true
+	if NewTable(config).filterEntry.Disabled() {
+		t.Error("Expected search box enabled with SearchAllColumns")
+	}
+}
+
+func TestFilterModeTerms(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	table.SetData(append(createTestData(), TestData{ID: 6, Name: "Eve", Status: "In Progress", Priority: 2}))
+
+	names := func() []string {
+		var result []string
+		for _, rowIdx := range table.state.visibleRows {
+			result = append(result, table.data[rowIdx].(TestData).Name)
+		}
+		return result
+	}
+
+	tests := []struct {
+		mode   FilterMode
+		filter string
+		want   []string
+	}{
+		{FilterPhrase, "alice active", nil}, // No cell contains the whole text
+		{FilterAnd, "alice active", []string{"Alice"}},
+		{FilterAnd, "  ALICE   pending ", []string{"alice"}},
+		{FilterAnd, `"in progress" eve`, []string{"Eve"}},
+		{FilterAnd, `"progress in"`, nil}, // Quoted terms match as a phrase
+		{FilterOr, "bob pending", []string{"Bob", "alice"}},
+		{FilterOr, `"in progress" bob`, []string{"Bob", "Eve"}},
+		{FilterOr, "nobody", nil},
+	}
+	for _, tt := range tests {
+		config.FilterMode = tt.mode
+		table.SetFilter(tt.filter, false)
+		if got := names(); !slices.Equal(got, tt.want) {
+			t.Errorf("Mode %d, filter %q: expected %v, got %v", tt.mode, tt.filter, tt.want, got)
+		}
+	}
+
+	// Regex search is never split into terms
+	config.FilterMode = FilterAnd
+	table.SetFilter("^(Bob|Eve)$", true)
+	if got := names(); !slices.Equal(got, []string{"Bob", "Eve"}) {
+		t.Errorf("Expected regex to match as a whole, got %v", got)
+	}
+
+	if got := splitFilterTerms(`a  "b c"   d"e "f g`); !slices.Equal(got, []string{"a", "b c", `d"e`, "f g"}) {
+		t.Errorf("splitFilterTerms: got %q", got)
+	}
+}
+
+func TestFilterFuzzy(t *testing.T) {
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{{ID: "name", Title: "Name"}}
+	config.FilterColumns = []string{"name"}
+	config.FilterMode = FilterFuzzy
+	table := createTestTable(config)
+	table.SetData([]interface{}{"Project Alpha", "Documentation", "Alpha Release", "Planning Phase"})
+
+	visible := func() []string {
+		var result []string
+		for _, rowIdx := range table.state.visibleRows {
+			result = append(result, table.data[rowIdx].(string))
+		}
+		return result
+	}
+
+	table.SetFilter("prjalpha", false)
+	if got := visible(); !slices.Equal(got, []string{"Project Alpha"}) {
+		t.Errorf("Expected \"prjalpha\" to find only Project Alpha, got %v", got)
+	}
+	table.SetFilter("pa", false) // Word starts in Project Alpha, compact matches in the others
+	if got := visible(); !slices.Equal(got, []string{"Project Alpha", "Alpha Release", "Planning Phase"}) {
+		t.Errorf("Expected \"pa\" to match every row with a p before an a, got %v", got)
+	}
+	table.SetFilter("dn", false) // Subsequence of "documentation", but too scattered
+	if got := visible(); len(got) != 0 {
+		t.Errorf("Expected no rows above the threshold for \"dn\", got %v", got)
+	}
+
+	// Regex and plain modes are unaffected
+	table.SetFilter("^.*alpha$", true)
+	if got := visible(); !slices.Equal(got, []string{"Project Alpha"}) {
+		t.Errorf("Expected regex matching, got %v", got)
+	}
+	config.FilterMode = FilterPhrase
+	table.SetFilter("prjalpha", false)
+	if got := visible(); len(got) != 0 {
+		t.Errorf("Expected no substring matches, got %v", got)
+	}
+
+	for _, tt := range []struct {
+		query, text string
+		want        float64
+	}{
+		{"alpha", "alpha release", 1},
+		{"prjalpha", "documentation", 0},
+		{"xyz", "abc", 0},
+		{"", "anything", 1},
+	} {
+		if got := fuzzyScore(tt.query, tt.text); got != tt.want {
+			t.Errorf("fuzzyScore(%q, %q) = %v, want %v", tt.query, tt.text, got, tt.want)
+		}
+	}
+	if scattered, compact := fuzzyScore("ace", "abcde"), fuzzyScore("ace", "ace of spades"); scattered >= compact {
+		t.Errorf("Expected compact match to score higher: scattered %v, compact %v", scattered, compact)
+	}
+}
+
+// ========== Test: Row Count ==========
+
+func TestRowCounts(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	if table.GetVisibleRowCount() != 5 || table.GetTotalRowCount() != 5 {
+		t.Errorf("Expected 5 of 5 rows, got %d of %d", table.GetVisibleRowCount(), table.GetTotalRowCount())
+	}
+
+	table.SetFilter("alice", false)
+	if table.GetVisibleRowCount() != 2 || table.GetTotalRowCount() != 5 {
+		t.Errorf("Expected 2 of 5 rows, got %d of %d", table.GetVisibleRowCount(), table.GetTotalRowCount())
+	}
+
+	// Group header rows are not counted
+	table.SetGroupBy("status")
+	if table.GetVisibleRowCount() != 2 {
+		t.Errorf("Expected 2 visible rows with grouping, got %d", table.GetVisibleRowCount())
+	}
+}
+
+func TestFormatRowCount(t *testing.T) {
+	if got := formatRowCount(message.NewPrinter(language.English), 42, 1000); got != "Showing 42 of 1,000 rows" {
+		t.Errorf("Unexpected English row count %q", got)
+	}
+	if got := formatRowCount(message.NewPrinter(language.German), 1234, 56789); got != "Showing 1.234 of 56.789 rows" {
+		t.Errorf("Unexpected German row count %q", got)
+	}
+}
+
+func TestRowCountStatusLine(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	config.ShowRowCount = true
+	table := NewTable(config)
+	table.rowCountPrinter = message.NewPrinter(language.English)
+	table.SetData(createTestData())
+
+	if table.rowCountLabel.Text != "Showing 5 of 5 rows" {
+		t.Errorf("Unexpected status line %q", table.rowCountLabel.Text)
+	}
+
+	table.SetFilter("bob", false)
+	if table.rowCountLabel.Text != "Showing 1 of 5 rows" {
+		t.Errorf("Expected status line to follow filter, got %q", table.rowCountLabel.Text)
+	}
+
+	// Without ShowRowCount there is no status line
+	if NewTable(createTestConfig()).rowCountLabel != nil {
+		t.Error("Expected no status line when ShowRowCount is false")
+	}
+}
+
+// ========== Test: Selection ==========
+
+func TestSetSelectedCellValid(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	data := createTestData()
+	table.SetData(data)
+
+	table.SetSelectedCell(2, 1)
+
+	row, col := table.GetSelectedCell()
+	if row != 2 || col != 1 {
+		t.Errorf("Expected selected cell (2, 1), got (%d, %d)", row, col)
+	}
+}
+
+func TestSetSelectedCellInvalidRow(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	data := createTestData()
+	table.SetData(data)
 
 	// Try to select row 100 (out of bounds)
 	table.SetSelectedCell(100, 1)
@@ -518,6 +1490,311 @@ func TestClearSelection(t *testing.T) {
 	}
 }
 
+func TestSelectAllVisible(t *testing.T) {
+	config := createTestConfig()
+	config.AllowMultiSelect = true
+	config.IsRowSelectable = func(data interface{}) bool { return data.(TestData).Name != "David" }
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	// Only rows passing the filter, without disabled ones
+	table.SetFilter("active", false) // Alice, Bob (Inactive), Charlie, David
+	table.SelectAllVisible()
+	if got := fmt.Sprint(table.GetSelectedRows()); got != "[0 1 2]" {
+		t.Errorf("Expected selected rows [0 1 2], got %s", got)
+	}
+
+	table.DeselectAll()
+	if table.GetSelectionCount() != 0 {
+		t.Errorf("Expected no selection after DeselectAll, got %v", table.GetSelectedRows())
+	}
+
+	// Ctrl+A selects all visible rows, in multi-select mode only
+	table.SetFilter("", false)
+	table.TypedShortcut(&fyne.ShortcutSelectAll{})
+	if got := fmt.Sprint(table.GetSelectedRows()); got != "[0 1 2 3]" {
+		t.Errorf("Expected Ctrl+A to select [0 1 2 3], got %s", got)
+	}
+	table.DeselectAll()
+	config.AllowMultiSelect = false
+	table.TypedShortcut(&fyne.ShortcutSelectAll{})
+	if table.GetSelectionCount() != 0 {
+		t.Errorf("Expected Ctrl+A to be ignored in single-select mode, got %v", table.GetSelectedRows())
+	}
+}
+
+func TestSetDataShrinkClampsSelection(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	table.SetSelectedCell(4, 1)
+	table.state.editingRow = 4
+	table.state.editingCol = 1
+
+	// Replace with fewer rows - selection must stay within bounds
+	table.SetData(createTestData()[:2])
+
+	row, col := table.GetSelectedCell()
+	if row != 1 || col != 1 {
+		t.Errorf("Expected selection clamped to (1, 1), got (%d, %d)", row, col)
+	}
+	if table.GetEditingState() {
+		t.Error("Expected editing state cleared for removed row")
+	}
+
+	// Rendering and navigation must not read past the new data
+	table.KeyHandler.HandleKey(&fyne.KeyEvent{Name: fyne.KeyDown}, table)
+	table.KeyHandler.HandleKey(&fyne.KeyEvent{Name: fyne.KeyEnd}, table)
+	if row, _ := table.GetSelectedCell(); row < 0 || row >= 2 {
+		t.Errorf("Expected selection within 2 rows after navigation, got %d", row)
+	}
+
+	// Replacing with no data clears selection
+	table.SetData([]interface{}{})
+	if row, _ := table.GetSelectedCell(); row != -1 {
+		t.Errorf("Expected cleared selection with empty data, got %d", row)
+	}
+}
+
+func TestSetDataShrinkDropsMultiSelectRows(t *testing.T) {
+	config := createTestConfig()
+	config.AllowMultiSelect = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	table.SetSelectedRows([]int{0, 2, 4})
+	table.SetData(createTestData()[:3])
+
+	selectedRows := table.GetSelectedRows()
+	if len(selectedRows) != 2 || selectedRows[0] != 0 || selectedRows[1] != 2 {
+		t.Errorf("Expected selected rows [0, 2] after shrink, got %v", selectedRows)
+	}
+}
+
+// focusRequestCounter is a FocusHandler that counts focus requests
+type focusRequestCounter struct {
+	DefaultFocusHandler
+	requests int
+}
+
+func (f *focusRequestCounter) RequestFocus(*Table) { f.requests++ }
+
+func TestInitialSelection(t *testing.T) {
+	tests := []struct {
+		name      string
+		configure func(config *Config)
+		wantRow   int
+		wantFocus bool
+	}{
+		{"none", func(*Config) {}, -1, false},
+		{"none with focus", func(c *Config) { c.FocusOnLoad = true }, -1, true},
+		{"first row", func(c *Config) { c.InitialSelection = InitialSelectionFirstRow }, 0, false},
+		{"last row", func(c *Config) { c.InitialSelection = InitialSelectionLastRow }, 4, false},
+		{"by key", func(c *Config) {
+			c.InitialSelection = InitialSelectionByKey
+			c.InitialSelectionKey = "3"
+		}, 2, false},
+		{"unknown key", func(c *Config) {
+			c.InitialSelection = InitialSelectionByKey
+			c.InitialSelectionKey = "99"
+		}, -1, false},
+		{"SelectFirstCellOnStartup", func(c *Config) { c.SelectFirstCellOnStartup = true }, 0, true},
+		{"last row with focus", func(c *Config) {
+			c.InitialSelection = InitialSelectionLastRow
+			c.FocusOnLoad = true
+		}, 4, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := createTestConfig()
+			config.RowKey = func(data interface{}) string { return fmt.Sprint(data.(TestData).ID) }
+			tt.configure(config)
+			table := createTestTable(config)
+			focus := &focusRequestCounter{}
+			table.FocusHandler = focus
+
+			table.SetData(createTestData())
+			row, col := table.GetSelectedCell()
+			if row != tt.wantRow {
+				t.Errorf("Expected row %d selected, got %d", tt.wantRow, row)
+			}
+			if row >= 0 && col != table.state.visibleColumns[0] {
+				t.Errorf("Expected first visible column selected, got %d", col)
+			}
+			if (focus.requests > 0) != tt.wantFocus {
+				t.Errorf("Expected focus requested = %v, got %d requests", tt.wantFocus, focus.requests)
+			}
+		})
+	}
+
+	// First/last row are the first/last rows shown, not data indices
+	config := createTestConfig()
+	config.InitialSelection = InitialSelectionLastRow
+	table := createTestTable(config)
+	table.SetFilter("li", false) // Alice, Charlie, alice
+	table.SetData(createTestData())
+	if row, _ := table.GetSelectedCell(); row != 3 {
+		t.Errorf("Expected last shown row 3 selected, got %d", row)
+	}
+}
+
+func TestSetDataPreservesSelection(t *testing.T) {
+	config := createTestConfig()
+	config.SelectFirstCellOnStartup = true
+	table := createTestTable(config)
+
+	// Initial load selects the first cell
+	table.SetData(createTestData())
+	if row, _ := table.GetSelectedCell(); row != 0 {
+		t.Fatalf("Expected startup selection on row 0, got %d", row)
+	}
+
+	// Same row count: the selection stays put instead of jumping to the top
+	table.SetSelectedCell(3, 0)
+	table.SetData(createTestData())
+	if row, _ := table.GetSelectedCell(); row != 3 {
+		t.Errorf("Expected selection to stay on row 3, got %d", row)
+	}
+
+	// Changed row count without PreserveScrollOnUpdate re-selects the first cell
+	table.SetData(createTestData()[:4])
+	if row, _ := table.GetSelectedCell(); row != 0 {
+		t.Errorf("Expected first cell re-selected, got %d", row)
+	}
+
+	// With GetNodeID the selection follows the row's ID through reordering
+	config.GetNodeID = func(data interface{}) interface{} { return data.(TestData).ID }
+	table.SetData(createTestData())
+	table.SetSelectedCell(1, 0) // Bob
+	reversed := createTestData()
+	for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+		reversed[i], reversed[j] = reversed[j], reversed[i]
+	}
+	table.SetData(reversed)
+	if row, _ := table.GetSelectedCell(); row != 3 || table.data[row].(TestData).Name != "Bob" {
+		t.Errorf("Expected selection to follow Bob to row 3, got %d", row)
+	}
+
+	// PreserveScrollOnUpdate keeps the selection when rows are added
+	config.PreserveScrollOnUpdate = true
+	table.SetData(append([]interface{}{TestData{ID: 9, Name: "Eve"}}, reversed...))
+	if row, _ := table.GetSelectedCell(); row != 4 || table.data[row].(TestData).Name != "Bob" {
+		t.Errorf("Expected selection to follow Bob to row 4, got %d", row)
+	}
+
+	// Multi-select rows are remapped too; IDs that disappear are dropped
+	config.AllowMultiSelect = true
+	table.SetSelectedRows([]int{0, 4}) // Eve, Bob
+	table.SetData(createTestData())
+	if rows := table.GetSelectedRows(); len(rows) != 1 || rows[0] != 1 {
+		t.Errorf("Expected only Bob (row 1) to remain selected, got %v", rows)
+	}
+}
+
+func TestRowKeySelection(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[1].Sortable = true
+	config.RowKey = func(data interface{}) string { return fmt.Sprint(data.(TestData).ID) }
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	if !table.SelectByKey("2") { // Bob
+		t.Fatal("Expected SelectByKey to find key 2")
+	}
+	if keys := table.GetSelectedKeys(); len(keys) != 1 || keys[0] != "2" {
+		t.Fatalf("Expected selected keys [2], got %v", keys)
+	}
+	if table.SelectByKey("missing") {
+		t.Error("Expected SelectByKey to report an unknown key")
+	}
+
+	// Sorting moves Bob to another data index; the selection follows him
+	if err := table.Sort("name", false); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	if row, _ := table.GetSelectedCell(); table.data[row].(TestData).Name != "Bob" {
+		t.Errorf("Expected selection to follow Bob through the sort, got %v", table.data[row])
+	}
+
+	// Filtering hides rows but keeps the selection on the same key
+	table.SetFilter("bob", false)
+	if keys := table.GetSelectedKeys(); len(keys) != 1 || keys[0] != "2" {
+		t.Errorf("Expected selection kept by key after filtering, got %v", keys)
+	}
+	table.SetFilter("", false)
+
+	// SetData with a different row count still follows the key
+	table.SetData(append([]interface{}{TestData{ID: 9, Name: "Eve"}}, createTestData()...))
+	if keys := table.GetSelectedKeys(); len(keys) != 1 || keys[0] != "2" {
+		t.Errorf("Expected selection kept by key after SetData, got %v", keys)
+	}
+
+	// Keys that disappear are dropped from a multi-selection
+	config.AllowMultiSelect = true
+	var rows []int
+	for i, item := range table.data {
+		if id := item.(TestData).ID; id == 2 || id == 9 {
+			rows = append(rows, i)
+		}
+	}
+	table.SetSelectedRows(rows)
+	table.SetData(createTestData()) // Eve (9) removed
+	if keys := table.GetSelectedKeys(); len(keys) != 1 || keys[0] != "2" {
+		t.Errorf("Expected only key 2 to remain selected, got %v", keys)
+	}
+
+	// Without RowKey the key API is inert
+	config.RowKey = nil
+	if table.SelectByKey("1") || table.GetSelectedKeys() != nil {
+		t.Error("Expected key API to be inert without RowKey")
+	}
+}
+
+// ========== Test: Accessibility ==========
+
+func TestAccessibleDescription(t *testing.T) {
+	config := createTestConfig()
+	var announced []string
+	config.OnAccessibilityAnnouncement = func(text string) {
+		announced = append(announced, text)
+	}
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	if got := table.AccessibleDescription(); got != "No selection" {
+		t.Errorf("Expected 'No selection', got %q", got)
+	}
+
+	// Row numbers follow the current view (Charlie is the 2nd Active row)
+	table.SetColumnFilterOp("status", FilterEquals, "Active")
+	table.state.selectedRow = 2
+	table.state.selectedCol = 2
+	if got := table.AccessibleDescription(); got != "Row 2, Status, Active" {
+		t.Errorf("Expected 'Row 2, Status, Active', got %q", got)
+	}
+
+	// Row without a selected column
+	table.state.selectedCol = -1
+	if got := table.AccessibleDescription(); got != "Row 2" {
+		t.Errorf("Expected 'Row 2', got %q", got)
+	}
+
+	// Multi-select summary
+	table.state.SetSelectedRows([]int{0, 2, 4})
+	if got := table.AccessibleDescription(); got != "3 rows selected" {
+		t.Errorf("Expected '3 rows selected', got %q", got)
+	}
+
+	// Clicking a cell announces the new selection
+	table.state.ClearSelection()
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	table.MouseHandler.HandleCellClick(widget.TableCellID{Row: 3, Col: 1}, table)
+	if len(announced) != 1 || announced[0] != "Row 3, Name, David" {
+		t.Errorf("Expected announcement 'Row 3, Name, David', got %v", announced)
+	}
+}
+
 // ========== Test: Column Visibility ==========
 
 func TestSetColumnVisibility(t *testing.T) {
@@ -568,6 +1845,73 @@ func TestSetColumnVisibilityInvalidID(t *testing.T) {
 	}
 }
 
+func TestColumnVisibilityChangedCallback(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+
+	type event struct {
+		id      string
+		visible bool
+		columns int // Visible columns seen by the handler
+	}
+	var events []event
+	config.OnColumnVisibilityChanged = func(columnID string, visible bool) {
+		events = append(events, event{columnID, visible, len(table.state.visibleColumns)})
+	}
+
+	table.SetColumnVisibility("status", false)
+	table.SetColumnVisibility("status", false) // Unchanged: no event
+	table.SetColumnVisibility("status", true)
+	table.SetColumnVisibility("missing", false)
+
+	want := []event{{"status", false, 3}, {"status", true, 4}}
+	if fmt.Sprint(events) != fmt.Sprint(want) {
+		t.Errorf("Expected events %v, got %v", want, events)
+	}
+}
+
+func TestSetColumnOrder(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[3].Sortable = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.SetSelectedCell(1, 1) // name
+	table.Sort("priority", true)
+
+	var orders [][]string
+	config.OnColumnOrderChanged = func(order []string) {
+		if table.config.Columns[0].ID != order[0] {
+			t.Error("Expected columns reordered before the callback")
+		}
+		orders = append(orders, order)
+	}
+
+	if err := table.SetColumnOrder([]string{"priority", "name", "id", "status"}); err != nil {
+		t.Fatalf("SetColumnOrder failed: %v", err)
+	}
+	if len(orders) != 1 || strings.Join(orders[0], ",") != "priority,name,id,status" {
+		t.Errorf("Expected one order event, got %v", orders)
+	}
+
+	// Selection and sort follow their columns
+	if _, col := table.GetSelectedCell(); config.Columns[col].ID != "name" {
+		t.Errorf("Expected selection to stay on name, now on %s", config.Columns[col].ID)
+	}
+	if columnID, _, sorted := table.GetSortState(); !sorted || columnID != "priority" {
+		t.Errorf("Expected sort to stay on priority, got %q", columnID)
+	}
+
+	// Invalid orders are rejected without changes or events
+	for _, order := range [][]string{{"id", "name"}, {"id", "name", "status", "bogus"}, {"id", "id", "status", "priority"}} {
+		if err := table.SetColumnOrder(order); err == nil {
+			t.Errorf("Expected error for order %v", order)
+		}
+	}
+	if len(orders) != 1 || config.Columns[0].ID != "priority" {
+		t.Error("Expected rejected orders to leave the columns unchanged")
+	}
+}
+
 // ========== Test: Column Read-Only ==========
 
 func TestSetColumnReadOnly(t *testing.T) {
@@ -635,25 +1979,439 @@ func TestSetMaxDepth(t *testing.T) {
 	}
 }
 
-// ========== Test: Rendering ==========
+// treeNode is a minimal hierarchical row for tree tests
+type treeNode struct {
+	ID       int
+	ParentID int // 0 = root
+	Depth    int
+	Name     string
+}
+
+func createTreeTable() *Table {
+	config := NewConfig("tree-table")
+	config.Columns = []ColumnConfig{{ID: "name", Title: "Name", TreeColumn: true}}
+	config.GetNodeID = func(data interface{}) interface{} { return data.(treeNode).ID }
+	config.GetNodeDepth = func(data interface{}) int { return data.(treeNode).Depth }
+	config.GetNodeParentID = func(data interface{}) interface{} {
+		if parent := data.(treeNode).ParentID; parent != 0 {
+			return parent
+		}
+		return nil
+	}
+	config.IsNodeExpandable = func(data interface{}) bool { return data.(treeNode).ID != 3 && data.(treeNode).ID != 4 }
 
-func TestCreateRendererDoesNotPanic(t *testing.T) {
-	config := createTestConfig()
 	table := createTestTable(config)
+	table.SetData([]interface{}{
+		treeNode{ID: 1, Depth: 0, Name: "Project"},
+		treeNode{ID: 2, ParentID: 1, Depth: 1, Name: "Phase"},
+		treeNode{ID: 3, ParentID: 2, Depth: 2, Name: "Task"},
+		treeNode{ID: 4, Depth: 0, Name: "Other"},
+	})
+	return table
+}
 
-	// CreateRenderer should not panic
-	defer func() {
-		if r := recover(); r != nil {
-			t.Errorf("CreateRenderer panicked: %v", r)
-		}
-	}()
+func TestToggleNodeExpansionHidesDescendants(t *testing.T) {
+	table := createTreeTable()
 
-	renderer := table.CreateRenderer()
+	if len(table.state.visibleRows) != 4 {
+		t.Fatalf("Expected all 4 rows visible initially, got %v", table.state.visibleRows)
+	}
 
-	if renderer == nil {
-		t.Error("CreateRenderer returned nil")
+	// Collapse the root: child and grandchild are hidden
+	table.ToggleNodeExpansion(0)
+	if got := table.state.visibleRows; len(got) != 2 || got[0] != 0 || got[1] != 3 {
+		t.Errorf("Expected visible rows [0 3] after collapsing root, got %v", got)
 	}
-}
+
+	// Expand it again
+	table.ToggleNodeExpansion(0)
+	if len(table.state.visibleRows) != 4 {
+		t.Errorf("Expected all 4 rows visible after re-expanding, got %v", table.state.visibleRows)
+	}
+}
+
+func TestTreeColumnToggle(t *testing.T) {
+	table := createTreeTable()
+
+	// Expandable node gets a ▼ toggle button wired to ToggleNodeExpansion
+	content := table.wrapTreeCell(table.data[1], 1, widget.NewLabel("Phase"))
+	toggle := findButton(content)
+	if toggle == nil {
+		t.Fatal("Expected expand/collapse toggle for expandable node")
+	}
+	if toggle.Text != "▼" {
+		t.Errorf("Expected expanded indicator ▼, got %q", toggle.Text)
+	}
+	toggle.OnTapped()
+	if table.isNodeExpanded(2) {
+		t.Error("Expected node 2 collapsed after tapping toggle")
+	}
+	if len(table.state.visibleRows) != 3 {
+		t.Errorf("Expected grandchild hidden after collapse, got %v", table.state.visibleRows)
+	}
+
+	// Leaf node shows the theme icon instead of a toggle
+	table.config.TreeIconTheme = TreeThemeAngles
+	leaf := table.wrapTreeCell(table.data[2], 2, widget.NewLabel("Task"))
+	if findButton(leaf) != nil {
+		t.Error("Expected no toggle for leaf node")
+	}
+
+	// Root leaf without indentation is returned unchanged
+	label := widget.NewLabel("Other")
+	if table.wrapTreeCell(table.data[3], 3, label) != label {
+		t.Error("Expected root leaf content to be returned unwrapped")
+	}
+}
+
+func TestConfigTreeColumn(t *testing.T) {
+	test.NewTempApp(t)
+
+	table := createTreeTable()
+	table.config.Columns[0].TreeColumn = false
+
+	render := func(row int) fyne.CanvasObject {
+		cell := container.NewStack(widget.NewLabel(""))
+		table.renderDataCell(0, row, cell)
+		return cell.Objects[0]
+	}
+
+	// Without a tree column the data renders flat
+	if findButton(render(1)) != nil {
+		t.Error("Expected no toggle without a tree column")
+	}
+	table.autoResizeColumn(0)
+	flatWidth := table.config.Columns[0].Width
+
+	// Designated by ID in the config, the default renderer draws the tree
+	table.config.TreeColumn = "name"
+	if findButton(render(1)) == nil {
+		t.Error("Expected expand toggle in Config.TreeColumn")
+	}
+
+	// Auto-resize leaves room for the indentation and toggle
+	table.autoResizeColumn(0)
+	if got := table.config.Columns[0].Width; got <= flatWidth+table.config.IndentPerLevel {
+		t.Errorf("Expected tree column wider than flat width %v plus one indent level, got %v", flatWidth, got)
+	}
+}
+
+// findButton returns the first button in a container tree
+func findButton(obj fyne.CanvasObject) *widget.Button {
+	switch o := obj.(type) {
+	case *widget.Button:
+		return o
+	case *fyne.Container:
+		for _, child := range o.Objects {
+			if b := findButton(child); b != nil {
+				return b
+			}
+		}
+	}
+	return nil
+}
+
+// ========== Test: Grouping ==========
+
+func TestSetGroupByInsertsHeaders(t *testing.T) {
+	config := createTestConfig()
+	config.GroupAggregateFunc = func(groupValue string, items []interface{}) string {
+		total := 0
+		for _, item := range items {
+			total += item.(TestData).Priority
+		}
+		return fmt.Sprintf("priority %d", total)
+	}
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	table.SetGroupBy("status")
+
+	// Groups in order of first appearance: Active(1,3,5), Inactive(2), Pending(4)
+	expected := []int{groupHeaderRow(0), 0, 2, 4, groupHeaderRow(1), 1, groupHeaderRow(2), 3}
+	if len(table.state.visibleRows) != len(expected) {
+		t.Fatalf("Expected visible rows %v, got %v", expected, table.state.visibleRows)
+	}
+	for i := range expected {
+		if table.state.visibleRows[i] != expected[i] {
+			t.Fatalf("Expected visible rows %v, got %v", expected, table.state.visibleRows)
+		}
+	}
+
+	if text := table.groupHeaderText(table.state.groups[0]); text != "▼ Active (3) — priority 7" {
+		t.Errorf("Unexpected group header text %q", text)
+	}
+
+	// Clearing grouping restores plain rows
+	table.SetGroupBy("")
+	if len(table.state.visibleRows) != 5 {
+		t.Errorf("Expected 5 visible rows without grouping, got %d", len(table.state.visibleRows))
+	}
+}
+
+func TestToggleGroupCollapsed(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.SetGroupBy("status")
+
+	table.ToggleGroupCollapsed("Active")
+	if !table.IsGroupCollapsed("Active") {
+		t.Error("Expected Active group to be collapsed")
+	}
+	// Header rows stay visible; Active's rows are hidden
+	if len(table.state.visibleRows) != 5 {
+		t.Errorf("Expected 5 visible rows (3 headers + 2 rows), got %d", len(table.state.visibleRows))
+	}
+	if text := table.groupHeaderText(table.state.groups[0]); text != "▶ Active (3)" {
+		t.Errorf("Unexpected collapsed group header text %q", text)
+	}
+
+	// Clicking a group header row toggles it back open
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	table.MouseHandler.HandleCellClick(widget.TableCellID{Row: 1, Col: 1}, table)
+	if table.IsGroupCollapsed("Active") {
+		t.Error("Expected Active group to be expanded after clicking its header")
+	}
+	if len(table.state.visibleRows) != 8 {
+		t.Errorf("Expected 8 visible rows after expanding, got %d", len(table.state.visibleRows))
+	}
+	if table.state.selectedRow != -1 {
+		t.Errorf("Expected clicking a group header not to select a row, got %d", table.state.selectedRow)
+	}
+}
+
+func TestGroupHeadersSkippedByNavigation(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.SetGroupBy("status")
+
+	table.state.selectedRow = 4 // Last Active row (David)
+	table.state.selectedCol = 0
+	table.KeyHandler.HandleKey(&fyne.KeyEvent{Name: fyne.KeyDown}, table)
+	if table.state.selectedRow != 1 {
+		t.Errorf("Expected Down to skip the Inactive header and select row 1, got %d", table.state.selectedRow)
+	}
+}
+
+// ========== Test: Selection Visibility ==========
+
+func TestFilterClearsHiddenSelection(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	table.SetSelectedCell(1, 0) // Bob
+	if !table.IsSelectionVisible() {
+		t.Fatal("Expected selection on a shown row to be visible")
+	}
+
+	table.SetFilter("alice", false)
+	if row, _ := table.GetSelectedCell(); row != -1 {
+		t.Errorf("Expected filtered-out selection to be cleared, got row %d", row)
+	}
+	if table.IsSelectionVisible() {
+		t.Error("Expected no visible selection after clearing")
+	}
+
+	// Multi-select keeps only the rows that are still shown
+	table.SetFilter("", false)
+	table.SetSelectedRows([]int{0, 1, 3}) // Alice, Bob, alice
+	table.SetFilter("alice", false)
+	if rows := table.GetSelectedRows(); len(rows) != 2 || rows[0] != 0 || rows[1] != 3 {
+		t.Errorf("Expected rows [0 3] to stay selected, got %v", rows)
+	}
+}
+
+func TestSelectionFollowsFilter(t *testing.T) {
+	config := createTestConfig()
+	config.SelectionFollowsFilter = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	table.SetSelectedCell(1, 0)  // Bob
+	table.SetFilter("li", false) // Alice, Charlie, alice
+	if row, _ := table.GetSelectedCell(); row != 2 {
+		t.Errorf("Expected selection to move to the next shown row 2, got %d", row)
+	}
+	if !table.IsSelectionVisible() {
+		t.Error("Expected the moved selection to be visible")
+	}
+
+	// With no following row shown, the selection moves back to the preceding one
+	table.SetFilter("", false)
+	table.SetSelectedCell(4, 0) // David
+	table.SetFilter("li", false)
+	if row, _ := table.GetSelectedCell(); row != 3 {
+		t.Errorf("Expected selection to move to the preceding shown row 3, got %d", row)
+	}
+
+	// Navigation continues from the moved selection
+	table.KeyHandler.HandleKey(&fyne.KeyEvent{Name: fyne.KeyUp}, table)
+	if row, _ := table.GetSelectedCell(); row != 2 {
+		t.Errorf("Expected Up to move from row 3 to row 2, got %d", row)
+	}
+}
+
+// ========== Test: Disabled Rows ==========
+
+func TestDisabledRowsSkippedByNavigation(t *testing.T) {
+	config := createTestConfig()
+	config.IsRowSelectable = func(data interface{}) bool { return data.(TestData).Name != "Bob" }
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	table.state.selectedRow = 0 // Alice
+	table.state.selectedCol = 0
+	table.KeyHandler.HandleKey(&fyne.KeyEvent{Name: fyne.KeyDown}, table)
+	if table.state.selectedRow != 2 {
+		t.Errorf("Expected Down to skip disabled Bob and select row 2, got %d", table.state.selectedRow)
+	}
+	table.KeyHandler.HandleKey(&fyne.KeyEvent{Name: fyne.KeyUp}, table)
+	if table.state.selectedRow != 0 {
+		t.Errorf("Expected Up to skip disabled Bob and select row 0, got %d", table.state.selectedRow)
+	}
+}
+
+func TestDisabledRowIgnoresClick(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[1].Editable = true
+	config.IsRowSelectable = func(data interface{}) bool { return data.(TestData).Name != "Bob" }
+	selectedCalls := 0
+	config.OnRowSelected = func(int, interface{}) { selectedCalls++ }
+	table := createTestTable(config)
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	table.SetData(createTestData())
+
+	table.MouseHandler.HandleCellClick(widget.TableCellID{Row: 1, Col: 1}, table) // Alice
+	table.MouseHandler.HandleCellClick(widget.TableCellID{Row: 2, Col: 1}, table) // Bob (disabled)
+	if row, _ := table.GetSelectedCell(); row != 0 {
+		t.Errorf("Expected click on disabled row to keep row 0 selected, got %d", row)
+	}
+	if selectedCalls != 1 {
+		t.Errorf("Expected OnRowSelected only for the enabled row, got %d calls", selectedCalls)
+	}
+
+	// Disabled rows cannot be edited even when selected programmatically
+	table.startEdit(1, 1)
+	if table.state.IsEditing() {
+		t.Error("Expected disabled row not to start editing")
+	}
+}
+
+// ========== Test: Full-Width Rows ==========
+
+// fullWidthBobConfig returns a test config that renders Bob's row (ID 2) as a full-width banner
+func fullWidthBobConfig() *Config {
+	config := createTestConfig()
+	config.FullWidthRowRenderer = func(rowIndex int, data interface{}) fyne.CanvasObject {
+		if data.(TestData).ID != 2 {
+			return nil
+		}
+		return widget.NewLabel("Section: Bob")
+	}
+	return config
+}
+
+func TestFullWidthRowRenders(t *testing.T) {
+	test.NewTempApp(t)
+	table := createTestTable(fullWidthBobConfig())
+	table.SetData(createTestData())
+
+	// Bob is data row 1, table row 2: the first column hosts the banner, the rest are blank
+	for col := range table.state.visibleColumns {
+		cell := table.tableCreateCell()
+		table.tableUpdateCell(widget.TableCellID{Row: 2, Col: col}, cell)
+		objects := cell.(*fyne.Container).Objects
+		if len(objects) != 1 {
+			t.Fatalf("Column %d: expected 1 object, got %d", col, len(objects))
+		}
+		label, ok := objects[0].(*widget.Label)
+		if !ok {
+			t.Fatalf("Column %d: expected a label, got %T", col, objects[0])
+		}
+		want := ""
+		if col == 0 {
+			want = "Section: Bob"
+		}
+		if label.Text != want {
+			t.Errorf("Column %d: expected %q, got %q", col, want, label.Text)
+		}
+	}
+
+	// Other rows still render their column values
+	cell := table.tableCreateCell()
+	table.tableUpdateCell(widget.TableCellID{Row: 1, Col: 1}, cell)
+	if label, ok := cell.(*fyne.Container).Objects[0].(*widget.Label); !ok || label.Text != "Alice" {
+		t.Errorf("Expected Alice's name cell to render normally, got %v", cell.(*fyne.Container).Objects[0])
+	}
+}
+
+func TestFullWidthRowsSkippedByNavigation(t *testing.T) {
+	config := fullWidthBobConfig()
+	config.Columns[1].Editable = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	table.state.selectedRow = 0 // Alice
+	table.state.selectedCol = 0
+	table.KeyHandler.HandleKey(&fyne.KeyEvent{Name: fyne.KeyDown}, table)
+	if table.state.selectedRow != 2 {
+		t.Errorf("Expected Down to skip full-width Bob and select row 2, got %d", table.state.selectedRow)
+	}
+
+	// Full-width rows are never edited, even when selectable
+	table.config.FullWidthRowsSelectable = true
+	table.state.selectedRow = 0
+	table.KeyHandler.HandleKey(&fyne.KeyEvent{Name: fyne.KeyDown}, table)
+	if table.state.selectedRow != 1 {
+		t.Errorf("Expected Down to select selectable full-width Bob, got %d", table.state.selectedRow)
+	}
+	table.startEdit(1, 1)
+	if table.state.IsEditing() {
+		t.Error("Expected full-width row not to start editing")
+	}
+}
+
+func TestFullWidthRowSkippedByAutoResize(t *testing.T) {
+	test.NewTempApp(t)
+	config := fullWidthBobConfig()
+	table := createTestTable(config)
+	data := createTestData()
+	bob := data[1].(TestData)
+	bob.Name = strings.Repeat("W", 80) // Would widen the column if measured
+	data[1] = bob
+	table.SetData(data)
+
+	table.autoResizeColumn(1)
+	withBanner := table.config.Columns[1].Width
+	table.config.FullWidthRowRenderer = nil
+	table.autoResizeColumn(1)
+	if withBanner >= table.config.Columns[1].Width {
+		t.Errorf("Expected the full-width row's value to be ignored by auto-resize (%v vs %v)", withBanner, table.config.Columns[1].Width)
+	}
+}
+
+// ========== Test: Rendering ==========
+
+func TestCreateRendererDoesNotPanic(t *testing.T) {
+	config := createTestConfig()
+	table := createTestTable(config)
+
+	// CreateRenderer should not panic
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("CreateRenderer panicked: %v", r)
+		}
+	}()
+
+	renderer := table.CreateRenderer()
+
+	if renderer == nil {
+		t.Error("CreateRenderer returned nil")
+	}
+}
 
 func TestCreateRendererHasCorrectStructure(t *testing.T) {
 	config := createTestConfig()
@@ -668,6 +2426,120 @@ func TestCreateRendererHasCorrectStructure(t *testing.T) {
 	}
 }
 
+func TestSortIndicator(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	config.Columns[0].Sortable = true
+	config.Columns[3].Sortable = true
+	config.Columns[3].Alignment = AlignRight
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	headerButton := func(col int) *widget.Button {
+		t.Helper()
+		cell := container.NewStack()
+		table.renderHeaderCell(col, cell)
+		button, ok := cell.Objects[0].(*widget.Button)
+		if !ok {
+			t.Fatalf("Expected header button, got %T", cell.Objects[0])
+		}
+		return button
+	}
+
+	// Default glyphs; right-aligned columns put the indicator before the title
+	table.Sort("id", true)
+	if text := headerButton(0).Text; text != "ID ▲" {
+		t.Errorf("Expected 'ID ▲', got %q", text)
+	}
+	table.Sort("priority", false)
+	if text := headerButton(3).Text; text != "▼ Priority" {
+		t.Errorf("Expected '▼ Priority', got %q", text)
+	}
+
+	// Custom and empty indicators
+	config.SortDescIndicator = "(desc)"
+	if text := headerButton(3).Text; text != "(desc) Priority" {
+		t.Errorf("Expected '(desc) Priority', got %q", text)
+	}
+	config.SortDescIndicator = ""
+	if text := headerButton(3).Text; text != "Priority" {
+		t.Errorf("Expected bare title, got %q", text)
+	}
+
+	// Icon mode leaves the title untouched and places the icon away from the aligned edge
+	config.SortIndicatorAsIcon = true
+	button := headerButton(3)
+	if button.Text != "Priority" || button.Icon == nil || button.IconPlacement != widget.ButtonIconLeadingText {
+		t.Errorf("Expected leading icon with bare title, got text=%q icon=%v placement=%v", button.Text, button.Icon, button.IconPlacement)
+	}
+	table.Sort("id", true)
+	button = headerButton(0)
+	if button.Text != "ID" || button.IconPlacement != widget.ButtonIconTrailingText {
+		t.Errorf("Expected trailing icon with bare title, got text=%q placement=%v", button.Text, button.IconPlacement)
+	}
+	if headerButton(3).Icon != nil {
+		t.Error("Expected no icon on unsorted column")
+	}
+}
+
+func TestHighlightMatches(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	config.FilterColumns = []string{"name"}
+	config.HighlightMatches = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	render := func(displayCol, dataIndex int) fyne.CanvasObject {
+		t.Helper()
+		cell := container.NewStack()
+		table.renderDataCell(displayCol, dataIndex, cell)
+		return cell.Objects[0]
+	}
+	segments := func(obj fyne.CanvasObject) []string {
+		t.Helper()
+		rich, ok := obj.(*widget.RichText)
+		if !ok {
+			t.Fatalf("Expected rich text, got %T", obj)
+		}
+		var parts []string
+		for _, segment := range rich.Segments {
+			text := segment.(*widget.TextSegment)
+			if text.Style.TextStyle.Bold {
+				parts = append(parts, "["+text.Text+"]")
+			} else {
+				parts = append(parts, text.Text)
+			}
+		}
+		return parts
+	}
+
+	// Plain text: each match is a bold segment
+	table.SetFilter("li", false)
+	if parts := segments(render(1, 0)); !slices.Equal(parts, []string{"A", "[li]", "ce"}) {
+		t.Errorf("Expected A [li] ce, got %v", parts)
+	}
+
+	// Regex: the span the pattern matched
+	table.SetFilter("^a", true)
+	if parts := segments(render(1, 0)); !slices.Equal(parts, []string{"[A]", "lice"}) {
+		t.Errorf("Expected [A] lice, got %v", parts)
+	}
+
+	// Columns that aren't searched keep plain labels
+	if _, ok := render(2, 0).(*widget.Label); !ok {
+		t.Errorf("Expected label in unsearched column, got %T", render(2, 0))
+	}
+
+	// Off by default
+	config.HighlightMatches = false
+	if _, ok := render(1, 0).(*widget.Label); !ok {
+		t.Errorf("Expected label with HighlightMatches off, got %T", render(1, 0))
+	}
+}
+
 // ========== Test: RebuildVisibleRows ==========
 
 func TestRebuildVisibleRowsNoFilter(t *testing.T) {
@@ -713,3 +2585,517 @@ func TestRebuildVisibleRowsWithFilter(t *testing.T) {
 		}
 	}
 }
+
+// ========== Test: Batch Update ==========
+
+func TestBatchUpdateRefreshesOnce(t *testing.T) {
+	test.NewTempApp(t)
+
+	refreshes := 0
+	original := refreshWidget
+	refreshWidget = func(*widget.Table) { refreshes++ }
+	defer func() { refreshWidget = original }()
+
+	table := createTestTable(createTestConfig())
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+
+	table.BeginUpdate()
+	table.SetData(createTestData())
+	table.BeginUpdate() // Nested
+	table.SetFilter("a", false)
+	table.Sort("name", true)
+	table.EndUpdate()
+	if refreshes != 0 {
+		t.Errorf("Expected no refresh until the outermost EndUpdate, got %d", refreshes)
+	}
+	table.SetSelectedCell(0, 1)
+	table.EndUpdate()
+	if refreshes != 1 {
+		t.Errorf("Expected 1 refresh for the batch, got %d", refreshes)
+	}
+
+	// Unmatched EndUpdate is a no-op and doesn't suppress later refreshes
+	table.EndUpdate()
+	if refreshes != 1 {
+		t.Errorf("Expected unmatched EndUpdate not to refresh, got %d refreshes", refreshes)
+	}
+	table.SetFilter("", false)
+	if refreshes == 1 {
+		t.Error("Expected refresh outside a batch")
+	}
+
+	// A batch with no changes doesn't refresh
+	before := refreshes
+	table.BeginUpdate()
+	table.EndUpdate()
+	if refreshes != before {
+		t.Errorf("Expected empty batch not to refresh, got %d refreshes", refreshes-before)
+	}
+}
+
+func TestBatchUpdateConcurrentWithNotify(t *testing.T) {
+	// Batches on the UI thread while a background goroutine changes the data, which
+	// notifies listeners and checks for a batch in progress (run with -race)
+	table := createTestTable(createTestConfig())
+	notified := make(chan struct{}, 1)
+	table.AddListener(binding.NewDataListener(func() {
+		select {
+		case notified <- struct{}{}:
+		default:
+		}
+	}))
+
+	started, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		close(started)
+		for range 200 {
+			table.SetData(createTestData())
+		}
+	}()
+	<-started
+	for range 200 {
+		table.BeginUpdate()
+		table.EndUpdate()
+	}
+	<-done
+
+	select {
+	case <-notified: // Drain notifications from the goroutine
+	default:
+	}
+	table.SetData(createTestData())
+	select {
+	case <-notified:
+	default:
+		t.Error("Expected listeners notified outside a batch")
+	}
+}
+
+func TestEditRefreshesOnlyAffectedCells(t *testing.T) {
+	test.NewTempApp(t)
+
+	fullRefreshes := 0
+	var cells []widget.TableCellID
+	originalFull, originalItem := refreshWidget, refreshWidgetItem
+	refreshWidget = func(*widget.Table) { fullRefreshes++ }
+	refreshWidgetItem = func(_ *widget.Table, id widget.TableCellID) { cells = append(cells, id) }
+	defer func() { refreshWidget, refreshWidgetItem = originalFull, originalItem }()
+
+	config := createTestConfig()
+	config.RowSelectOnlyMode = false
+	config.Columns[1].Editable = true
+	config.Columns[2].ShowCheckbox = true
+	config.Columns[2].GetCheckboxValue = func(data interface{}) bool { return data.(TestData).Active }
+	config.Columns[2].OnCheckboxChanged = func(interface{}, bool, int) {}
+	table := createTestTable(config)
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	table.SetData(createTestData())
+	table.SetColumnVisibility("id", false) // name is display column 0, status 1
+	table.SetFilter("active", false)       // Hides alice: David (data 4) is display row 3
+	fullRefreshes, cells = 0, nil
+
+	// Starting and saving an edit re-render only David's name cell (table row 4, after the header)
+	table.SetSelectedCell(4, 1)
+	fullRefreshes, cells = 0, nil
+	table.startEdit(4, 1)
+	table.editingEntry = newEscapeableEntry("Dave", table.cancelEdit, table.saveEdit)
+	table.saveEdit()
+	if fullRefreshes != 0 {
+		t.Errorf("Expected no full refresh for an edit, got %d", fullRefreshes)
+	}
+	davidName := widget.TableCellID{Row: 4, Col: 0}
+	if len(cells) != 2 || cells[0] != davidName || cells[1] != davidName {
+		t.Errorf("Expected only %v refreshed (start and save), got %v", davidName, cells)
+	}
+	if row, col := table.GetSelectedCell(); row != 4 || col != 1 {
+		t.Errorf("Expected selection to stay on (4, 1), got (%d, %d)", row, col)
+	}
+
+	// Toggling a checkbox elsewhere re-renders that cell and the previously selected one
+	cells = nil
+	h := table.MouseHandler.(*DefaultMouseHandler)
+	h.activateInteractiveCell(table, 0, 2)
+	if fullRefreshes != 0 {
+		t.Errorf("Expected no full refresh for a checkbox toggle, got %d", fullRefreshes)
+	}
+	aliceStatus := widget.TableCellID{Row: 1, Col: 1}
+	if len(cells) != 2 || cells[0] != davidName || cells[1] != aliceStatus {
+		t.Errorf("Expected %v and %v refreshed, got %v", davidName, aliceStatus, cells)
+	}
+
+	// Row highlighting re-renders the whole row; hidden cells are skipped
+	config.RowSelectOnlyMode = true
+	cells = nil
+	table.moveSelectionTo(3, 1) // alice is filtered out
+	if len(cells) != 3 {
+		t.Fatalf("Expected Alice's 3 visible cells refreshed, got %v", cells)
+	}
+	for _, cell := range cells {
+		if cell.Row != 1 {
+			t.Errorf("Expected only Alice's row refreshed, got %v", cells)
+		}
+	}
+}
+
+// ========== Test: Change Listeners ==========
+
+func TestListenerNotifiedOncePerChange(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[1].Sortable = true
+	table := createTestTable(config)
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	notified := 0
+	table.AddListener(binding.NewDataListener(func() { notified++ }))
+
+	changes := []struct {
+		name   string
+		change func()
+	}{
+		{"data", func() { table.SetData(createTestData()) }},
+		{"sort", func() { table.Sort("name", true) }},
+		{"header click", func() { table.handleHeaderClick(1) }},
+		{"filter", func() { table.SetFilter("active", false) }},
+		{"column filter", func() { table.SetColumnFilterOp("status", FilterEquals, "Active") }},
+		{"selection", func() { table.SetSelectedCell(0, 0) }},
+		{"keyboard selection", func() { table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyDown}) }},
+		{"click selection", func() { table.handleCellClick(widget.TableCellID{Row: 1, Col: 1}) }},
+		{"visibility", func() { table.SetColumnVisibility("id", false) }}, // Also moves the selected column
+		{"clear filter", func() { table.ClearFilter() }},                  // Search and column filters
+	}
+	for _, c := range changes {
+		notified = 0
+		c.change()
+		if notified != 1 {
+			t.Errorf("%s: expected 1 notification, got %d", c.name, notified)
+		}
+	}
+
+	// Operations that change nothing don't notify
+	notified = 0
+	table.SetColumnVisibility("id", false)
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft}) // Row-only mode: no column movement
+	if notified != 0 {
+		t.Errorf("Expected no notifications for unchanged state, got %d", notified)
+	}
+}
+
+func TestListenerDeferredDuringBatchUpdate(t *testing.T) {
+	table := createTestTable(createTestConfig())
+	notified := 0
+	listener := binding.NewDataListener(func() { notified++ })
+	table.AddListener(listener)
+
+	table.BeginUpdate()
+	table.SetData(createTestData())
+	table.SetFilter("a", false)
+	table.SetSelectedCell(0, 0)
+	if notified != 0 {
+		t.Errorf("Expected no notifications during a batch, got %d", notified)
+	}
+	table.EndUpdate()
+	if notified != 1 {
+		t.Errorf("Expected 1 notification at EndUpdate, got %d", notified)
+	}
+
+	table.RemoveListener(listener)
+	table.SetFilter("", false)
+	if notified != 1 {
+		t.Errorf("Expected no notifications after RemoveListener, got %d", notified-1)
+	}
+}
+
+// ========== Test: Detail Rows ==========
+
+func TestToggleRowDetail(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	config.DetailRenderer = func(data interface{}) fyne.CanvasObject {
+		detail := canvas.NewRectangle(color.Transparent)
+		detail.SetMinSize(fyne.NewSize(10, 50))
+		return detail
+	}
+	table := createTestTable(config)
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	table.SetData(createTestData())
+
+	base := table.baseRowHeight()
+	detailHeight := base + theme.Padding() + 50
+	rowHeight := func(tableRow int) float32 {
+		heights, _ := table.tableInternal("rowHeights").Interface().(map[int]float32)
+		if h, ok := heights[tableRow]; ok {
+			return h
+		}
+		return base
+	}
+	// Cells of a row with an open detail keep their content at the top (in a border
+	// container); the detail itself is drawn by the overlay (see TestRowDetailSpansRow)
+	topAligned := func(displayCol, dataIndex int) bool {
+		cell := container.NewStack(widget.NewLabel(""))
+		table.renderDataCell(displayCol, dataIndex, cell)
+		border, ok := cell.Objects[0].(*fyne.Container)
+		return ok && len(border.Objects) == 1
+	}
+
+	table.ToggleRowDetail(1)
+	if !table.IsRowDetailOpen(1) || rowHeight(2) != detailHeight {
+		t.Fatalf("Expected row 1 open with height %v, got open=%v height=%v", detailHeight, table.IsRowDetailOpen(1), rowHeight(2))
+	}
+	if !topAligned(0, 1) || !topAligned(3, 1) || topAligned(0, 0) {
+		t.Error("Expected every cell of the open row, and only those, top-aligned")
+	}
+
+	// Single-open: opening another row closes the first
+	table.ToggleRowDetail(3)
+	if table.IsRowDetailOpen(1) || !table.IsRowDetailOpen(3) {
+		t.Error("Expected opening row 3 to close row 1")
+	}
+	if rowHeight(2) != base || rowHeight(4) != detailHeight {
+		t.Errorf("Expected heights base/detail, got %v/%v", rowHeight(2), rowHeight(4))
+	}
+
+	// Heights follow the row when filtering moves it
+	table.SetFilter("alice", false) // Rows 0 and 3
+	if rowHeight(2) != detailHeight || rowHeight(4) != base {
+		t.Errorf("Expected detail height to follow row 3 to table row 2, got %v/%v", rowHeight(2), rowHeight(4))
+	}
+	table.SetFilter("", false)
+
+	// Multiple open details
+	config.MultipleDetailRows = true
+	table.ToggleRowDetail(1)
+	if !table.IsRowDetailOpen(1) || !table.IsRowDetailOpen(3) {
+		t.Error("Expected both rows open with MultipleDetailRows")
+	}
+
+	// Toggling closes
+	table.ToggleRowDetail(3)
+	if table.IsRowDetailOpen(3) || rowHeight(4) != base {
+		t.Error("Expected second toggle to close the detail")
+	}
+
+	// Sorting moves rows to other indices, so details close
+	config.Columns[1].Sortable = true
+	if err := table.Sort("name", true); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	if table.IsRowDetailOpen(1) || rowHeight(2) != base {
+		t.Error("Expected sorting to close details")
+	}
+
+	// No renderer, no details
+	config.DetailRenderer = nil
+	table.ToggleRowDetail(0)
+	if table.IsRowDetailOpen(0) {
+		t.Error("Expected no detail without DetailRenderer")
+	}
+}
+
+func TestRowDetailSpansRow(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	config.DetailRenderer = func(data interface{}) fyne.CanvasObject {
+		detail := canvas.NewRectangle(color.Transparent)
+		detail.SetMinSize(fyne.NewSize(10, 50))
+		return detail
+	}
+	table := NewTable(config)
+	table.SetData(createTestData())
+	w := test.NewWindow(table)
+	defer w.Close()
+	w.Resize(fyne.NewSize(600, 200)) // Short enough to scroll
+
+	table.ToggleRowDetail(1)
+	detail := table.detailObjects[1]
+	checkPlacement := func(when string) {
+		t.Helper()
+		first, rowSize, _ := table.CellBounds(1, 0)
+		last, lastSize, _ := table.CellBounds(1, len(config.Columns)-1)
+		// Detail position relative to the table widget, through the overlay
+		pos := table.detailOverlay.Position().Add(detail.Position())
+		if want := fyne.NewPos(first.X, first.Y+rowSize.Height-50); pos != want {
+			t.Errorf("%s: expected detail at %v, got %v", when, want, pos)
+		}
+		if want := fyne.NewSize(last.X+lastSize.Width-first.X, 50); detail.Size() != want {
+			t.Errorf("%s: expected detail spanning the row, size %v, got %v", when, want, detail.Size())
+		}
+	}
+	if objects := test.TempWidgetRenderer(t, table.detailOverlay).Objects(); len(objects) != 1 || objects[0] != detail {
+		t.Fatalf("Expected the overlay to draw the open detail, got %v", objects)
+	}
+	checkPlacement("unscrolled")
+	if top, _, _ := table.CellBounds(0, 0); table.detailOverlay.Position().Y != top.Y {
+		t.Errorf("Expected the overlay to start at the first data row (%v), got %v", top.Y, table.detailOverlay.Position().Y)
+	}
+
+	// Details follow scrolling
+	content := table.tableInternal("content").Interface().(*container.Scroll)
+	content.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(0, -30)})
+	if table.scrollOffset().Y == 0 {
+		t.Fatal("Expected the table to scroll")
+	}
+	checkPlacement("scrolled")
+
+	// Closed and filtered-out details are not drawn
+	table.SetFilter("bob", false)
+	if objects := test.TempWidgetRenderer(t, table.detailOverlay).Objects(); len(objects) != 1 {
+		t.Errorf("Expected the detail of the shown row drawn, got %d objects", len(objects))
+	}
+	table.SetFilter("alice", false)
+	if objects := test.TempWidgetRenderer(t, table.detailOverlay).Objects(); len(objects) != 0 {
+		t.Errorf("Expected no detail drawn for a filtered-out row, got %d objects", len(objects))
+	}
+	table.SetFilter("", false)
+	table.ToggleRowDetail(1)
+	if objects := test.TempWidgetRenderer(t, table.detailOverlay).Objects(); len(objects) != 0 {
+		t.Errorf("Expected no detail drawn once closed, got %d objects", len(objects))
+	}
+}
+
+// ========== Test: Visible Data ==========
+
+func TestVisibleData(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[3].Sortable = true
+	config.Columns[3].Comparator = NewNumericComparator("priority")
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	if err := table.Sort("priority", false); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	table.SetFilter("active", false) // Alice, Charlie, David (and Bob: "Inactive")
+
+	// Order matches the rendered rows
+	var rendered []string
+	for _, row := range table.state.visibleRows {
+		rendered = append(rendered, table.extractFieldValue(table.data[row], "name"))
+	}
+	var names []string
+	for _, item := range table.VisibleData() {
+		names = append(names, item.(TestData).Name)
+	}
+	if strings.Join(names, ",") != strings.Join(rendered, ",") || strings.Join(names, ",") != "David,Bob,Charlie,Alice" {
+		t.Errorf("Expected VisibleData in display order David,Bob,Charlie,Alice (rendered %v), got %v", rendered, names)
+	}
+
+	// Indices and early stop
+	var visited []int
+	table.ForEachVisibleRow(func(displayIndex, dataIndex int, data interface{}) bool {
+		if displayIndex != len(visited) {
+			t.Errorf("Expected display index %d, got %d", len(visited), displayIndex)
+		}
+		if table.GetData()[dataIndex] != data {
+			t.Errorf("Data index %d doesn't match item %v", dataIndex, data)
+		}
+		visited = append(visited, dataIndex)
+		return len(visited) < 2
+	})
+	if len(visited) != 2 {
+		t.Errorf("Expected iteration to stop after 2 rows, visited %d", len(visited))
+	}
+
+	// Group headers are skipped
+	table.SetFilter("", false)
+	table.SetGroupBy("status")
+	if got := len(table.VisibleData()); got != 5 {
+		t.Errorf("Expected 5 data rows with grouping, got %d", got)
+	}
+}
+
+// ========== Test: Snapshots ==========
+
+func TestSnapshotRestoresLayout(t *testing.T) {
+	test.NewTempApp(t)
+	config := createTestConfig()
+	for i := range config.Columns {
+		config.Columns[i].Width = float32(80 + 10*i)
+		config.Columns[i].Sortable = true
+	}
+	table := NewTable(config)
+	table.SetData(createTestData())
+	table.SetColumnVisibility("priority", false)
+	table.SetSortKeys([]SortKey{{ColumnID: "name", Ascending: false}, {ColumnID: "status", Ascending: true}})
+	table.SetFilter("active", false)
+	table.SetSelectedCell(table.state.visibleRows[0], 1)
+	saved := table.Snapshot()
+
+	// Change the layout and view
+	table.setColumnWidth(0, 150)
+	table.SetColumnVisibility("priority", true)
+	table.SetColumnVisibility("status", false)
+	if err := table.SetColumnOrder([]string{"status", "priority", "name", "id"}); err != nil {
+		t.Fatalf("SetColumnOrder failed: %v", err)
+	}
+	table.Sort("id", true)
+	table.SetFilter("", false)
+	changed := table.Snapshot()
+	if diff := saved.Diff(changed); len(diff) != 4 {
+		t.Errorf("Expected all 4 columns to differ, got %v", diff)
+	}
+
+	if err := table.ApplySnapshot(saved); err != nil {
+		t.Fatalf("ApplySnapshot failed: %v", err)
+	}
+	restored := table.Snapshot()
+	if diff := saved.Diff(restored); len(diff) != 0 {
+		t.Errorf("Expected the saved layout back, columns %v differ: %+v", diff, restored.Columns)
+	}
+	widths, _ := table.tableInternal("columnWidths").Interface().(map[int]float32)
+	for displayIdx, colIndex := range table.state.visibleColumns {
+		if got, want := widths[displayIdx], table.config.Columns[colIndex].Width; got != want {
+			t.Errorf("Table widget column %d width = %v, want %v", displayIdx, got, want)
+		}
+	}
+	if keys := table.GetSortKeys(); fmt.Sprint(keys) != "[{name false} {status true}]" {
+		t.Errorf("Expected sort name descending then status ascending, got %v", keys)
+	}
+	if text, _ := table.GetFilter(); text != "active" || table.GetVisibleRowCount() != 4 {
+		t.Errorf("Expected filter \"active\" with 4 rows, got %q with %d", text, table.GetVisibleRowCount())
+	}
+	if row, col := table.GetSelectedCell(); row != saved.State.SelectedRow || col != 1 {
+		t.Errorf("Expected selection (%d, 1), got (%d, %d)", saved.State.SelectedRow, row, col)
+	}
+}
+
+func TestApplySnapshotRestoresUnsortedOrder(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[3].Sortable = true // priority
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	saved := table.Snapshot()
+
+	if err := table.Sort("priority", false); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	if err := table.ApplySnapshot(saved); err != nil {
+		t.Fatalf("ApplySnapshot failed: %v", err)
+	}
+	if _, _, sorted := table.GetSortState(); sorted {
+		t.Error("Expected no sort after applying an unsorted snapshot")
+	}
+	for i, item := range table.GetData() {
+		if id := item.(TestData).ID; id != i+1 {
+			t.Errorf("Expected rows back in the order set, row %d has ID %d", i, id)
+		}
+	}
+}
+
+func TestApplySnapshotRejectsMismatchedColumns(t *testing.T) {
+	table := createTestTable(createTestConfig())
+	table.SetData(createTestData())
+	snap := table.Snapshot()
+	snap.Columns = snap.Columns[1:]
+
+	if err := table.ApplySnapshot(snap); err == nil {
+		t.Error("Expected an error for a snapshot missing a column")
+	}
+	if table.config.Columns[0].ID != "id" || len(table.state.visibleColumns) != 4 {
+		t.Error("Expected the layout to be unchanged after a rejected snapshot")
+	}
+	if err := table.ApplySnapshot(nil); err == nil {
+		t.Error("Expected an error for a nil snapshot")
+	}
+}
@@ -1,8 +1,22 @@
 package table
 
 import (
+	"context"
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
+	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
 )
 
@@ -90,6 +104,45 @@ func TestSortData(t *testing.T) {
 	}
 }
 
+// TestHeaderSortCycle tests that the three-state cycle clears sorting on the third click
+func TestHeaderSortCycle(t *testing.T) {
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{{ID: "col1", Title: "Column 1", Sortable: true}}
+
+	table := createTestTable(config)
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	table.SetData([]interface{}{"mango", "zebra", "apple"})
+
+	// Two-state (default): third click sorts ascending again
+	table.handleHeaderClick(0)
+	table.handleHeaderClick(0)
+	table.handleHeaderClick(0)
+	if !table.state.IsSorted() || !table.state.sortAsc || table.data[0] != "apple" {
+		t.Errorf("Two-state: expected ascending sort after third click, got sorted=%v asc=%v data=%v",
+			table.state.IsSorted(), table.state.sortAsc, table.data)
+	}
+
+	config.HeaderSortCycle = SortCycleThreeState
+	table.handleHeaderClick(0) // Descending
+	if table.state.sortAsc || table.data[0] != "zebra" {
+		t.Fatalf("Expected descending sort, got asc=%v data=%v", table.state.sortAsc, table.data)
+	}
+	table.handleHeaderClick(0) // Unsorted
+	if table.state.IsSorted() {
+		t.Error("Expected third click to clear sorting")
+	}
+	want := []interface{}{"mango", "zebra", "apple"}
+	for i := range want {
+		if table.data[i] != want[i] {
+			t.Fatalf("Expected original order %v, got %v", want, table.data)
+		}
+	}
+	table.handleHeaderClick(0) // Cycle restarts
+	if !table.state.IsSorted() || !table.state.sortAsc {
+		t.Error("Expected next click to sort ascending again")
+	}
+}
+
 // TestHandleHeaderClick tests clicking column headers to toggle sort
 func TestHandleHeaderClick(t *testing.T) {
 	config := NewConfig("test")
@@ -136,6 +189,181 @@ func TestHandleHeaderClick(t *testing.T) {
 	}
 }
 
+// TestHeaderShiftClickAddsSortKey tests that Shift-clicking a header adds a sort key
+// instead of replacing the sort
+func TestHeaderShiftClickAddsSortKey(t *testing.T) {
+	var modifiers fyne.KeyModifier
+	original := currentKeyModifiers
+	currentKeyModifiers = func() fyne.KeyModifier { return modifiers }
+	defer func() { currentKeyModifiers = original }()
+
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "status", Title: "Status", Sortable: true, SortKey: func(data interface{}) string { return strings.Fields(data.(string))[0] }},
+		{ID: "task", Title: "Task", Sortable: true, SortKey: func(data interface{}) string { return strings.Fields(data.(string))[1] }},
+	}
+	config.HeaderSortCycle = SortCycleThreeState
+
+	table := createTestTable(config)
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	table.SetData([]interface{}{"open b", "done c", "open a", "done a"})
+	expect := func(step string, data []interface{}, indicators ...string) {
+		t.Helper()
+		if !slices.Equal(table.data, data) {
+			t.Errorf("%s: expected %v, got %v", step, data, table.data)
+		}
+		for col, indicator := range indicators {
+			if got := table.sortIndicatorText(col); got != indicator {
+				t.Errorf("%s: expected column %d indicator %q, got %q", step, col, indicator, got)
+			}
+		}
+	}
+
+	table.handleHeaderClick(0)
+	expect("click status", []interface{}{"done c", "done a", "open b", "open a"}, "▲", "")
+
+	modifiers = fyne.KeyModifierShift
+	table.handleHeaderClick(1)
+	expect("shift-click task", []interface{}{"done a", "done c", "open a", "open b"}, "▲1", "▲2")
+	table.handleHeaderClick(1)
+	expect("shift-click task again", []interface{}{"done c", "done a", "open b", "open a"}, "▲1", "▼2")
+	table.handleHeaderClick(0)
+	expect("shift-click status", []interface{}{"open b", "open a", "done c", "done a"}, "▼1", "▼2")
+
+	// Three-state: Shift-clicking a descending key removes it, promoting the next one
+	table.handleHeaderClick(0)
+	if keys := table.GetSortKeys(); len(keys) != 1 || keys[0].ColumnID != "task" || keys[0].Ascending {
+		t.Errorf("Expected task descending alone, got %v", keys)
+	}
+	expect("remove status", table.data, "", "▼")
+
+	// A plain click replaces the keys
+	table.handleHeaderClick(0)
+	modifiers = 0
+	table.handleHeaderClick(0)
+	if keys := table.GetSortKeys(); len(keys) != 1 || keys[0].ColumnID != "status" || !keys[0].Ascending {
+		t.Errorf("Expected plain click to sort by status alone, got %v", keys)
+	}
+}
+
+// TestOnSortChanged tests that OnSortChanged reports sort changes by column ID
+func TestOnSortChanged(t *testing.T) {
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "col1", Title: "Column 1"},
+		{ID: "col2", Title: "Column 2", Sortable: true},
+	}
+	var changes []string
+	config.OnSortChanged = func(columnID string, ascending bool) {
+		changes = append(changes, fmt.Sprintf("%s/%v", columnID, ascending))
+	}
+
+	table := createTestTable(config)
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+	table.SetData([]interface{}{"zebra", "apple"})
+
+	table.handleHeaderClick(0) // Not sortable: no change
+	table.handleHeaderClick(1)
+	table.handleHeaderClick(1)
+	table.Sort("col1", true) // Rejected: no change
+	table.Sort("col2", true)
+	table.ClearSort()
+
+	want := []string{"col2/true", "col2/false", "col2/true", "/true"}
+	if !slices.Equal(changes, want) {
+		t.Errorf("Expected changes %v, got %v", want, changes)
+	}
+}
+
+// TestOnHeaderClick tests that OnHeaderClick can claim header clicks and suppress sorting
+func TestOnHeaderClick(t *testing.T) {
+	var modifiers fyne.KeyModifier
+	original := currentKeyModifiers
+	currentKeyModifiers = func() fyne.KeyModifier { return modifiers }
+	defer func() { currentKeyModifiers = original }()
+
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "col1", Title: "Column 1", Sortable: true},
+	}
+	var clicks []string
+	config.OnHeaderClick = func(columnID string, mods fyne.KeyModifier) bool {
+		clicks = append(clicks, columnID)
+		return mods&fyne.KeyModifierAlt != 0
+	}
+
+	table := createTestTable(config)
+	table.SetData([]interface{}{"zebra", "apple"})
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+
+	// Alt-click is handled by the callback: no sort
+	modifiers = fyne.KeyModifierAlt
+	table.handleHeaderClick(0)
+	if table.state.IsSorted() || table.data[0] != "zebra" {
+		t.Errorf("Expected Alt-click not to sort, sortColumn=%d data=%v", table.state.sortColumn, table.data)
+	}
+
+	// Plain click falls through to the default sort
+	modifiers = 0
+	table.handleHeaderClick(0)
+	if table.state.sortColumn != 0 || table.data[0] != "apple" {
+		t.Errorf("Expected plain click to sort, sortColumn=%d data=%v", table.state.sortColumn, table.data)
+	}
+
+	if len(clicks) != 2 || clicks[0] != "col1" {
+		t.Errorf("Expected OnHeaderClick called twice with col1, got %v", clicks)
+	}
+}
+
+// TestSortDuringEditCancelsEdit tests that sorting while editing does not save the edit to another row
+func TestSortDuringEditCancelsEdit(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "col1", Title: "Column 1", Sortable: true, Editable: true},
+	}
+	var edits []string
+	config.OnCellEdited = func(rowIndex int, colID string, newValue string, data interface{}) {
+		edits = append(edits, fmt.Sprintf("%v=%s", data, newValue))
+	}
+
+	table := createTestTable(config)
+	table.SetData([]interface{}{"zebra", "apple", "mango"})
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+
+	// Edit "zebra" (row 0), then sort via header click while the edit is open
+	table.startEdit(0, 0)
+	table.renderDataCell(0, 0, container.NewStack())
+	entry := table.editingEntry
+	entry.SetText("zebra-edited")
+
+	table.handleHeaderClick(0)
+	if table.state.IsEditing() {
+		t.Fatal("Expected sorting to cancel the in-progress edit")
+	}
+	if table.data[0] != "apple" {
+		t.Errorf("Expected data[0] = apple after sort, got %v", table.data[0])
+	}
+
+	// Submitting the stale entry must not write to the row now at index 0
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	if len(edits) != 0 {
+		t.Errorf("Expected no edits to be saved, got %v", edits)
+	}
+
+	// Same for programmatic Sort
+	table.startEdit(2, 0)
+	table.renderDataCell(0, 2, container.NewStack())
+	table.editingEntry.SetText("changed")
+	if err := table.Sort("col1", false); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	if table.state.IsEditing() || len(edits) != 0 {
+		t.Errorf("Expected Sort to cancel the edit without saving, editing=%v edits=%v", table.state.IsEditing(), edits)
+	}
+}
+
 // TestSortDataWithStructs tests sorting complex data structures
 func TestSortDataWithStructs(t *testing.T) {
 	type Task struct {
@@ -237,6 +465,146 @@ func TestStartEdit(t *testing.T) {
 	}
 }
 
+// TestEditEntryPreservedAcrossRefresh tests that re-rendering an editing cell keeps the entry and typed text
+func TestEditEntryPreservedAcrossRefresh(t *testing.T) {
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "col1", Title: "Column 1", Editable: true},
+	}
+
+	table := &Table{
+		config: config,
+		data:   []interface{}{"apple", "banana"},
+		state: &TableState{
+			editingRow:     -1,
+			editingCol:     -1,
+			visibleColumns: []int{0},
+		},
+	}
+
+	table.startEdit(0, 0)
+	cell := container.NewStack()
+	table.renderDataCell(0, 0, cell)
+
+	entry := table.editingEntry
+	if entry == nil {
+		t.Fatal("Expected edit entry to be created on render")
+	}
+	entry.SetText("apple pie")
+
+	// Simulate background refreshes re-rendering the editing cell
+	table.renderDataCell(0, 0, cell)
+	table.renderDataCell(0, 0, container.NewStack(widget.NewLabel("recycled")))
+
+	if table.editingEntry != entry {
+		t.Error("Expected edit entry to be reused across refreshes")
+	}
+	if table.editingEntry.Text != "apple pie" {
+		t.Errorf("Expected in-progress text 'apple pie', got %q", table.editingEntry.Text)
+	}
+
+	// A new edit gets a fresh entry with the new cell's value
+	table.cancelEdit()
+	table.startEdit(1, 0)
+	table.renderDataCell(0, 1, cell)
+	if table.editingEntry == entry || table.editingEntry.Text != "banana" {
+		t.Errorf("Expected a fresh entry with 'banana', got %q", table.editingEntry.Text)
+	}
+}
+
+// TestFocusEditEntry tests that a new edit entry is focused once it is in a canvas, and stale entries are not
+func TestFocusEditEntry(t *testing.T) {
+	test.NewTempApp(t)
+
+	table := &Table{config: NewConfig("test"), state: NewTableState()}
+	entry := newEscapeableEntry("apple", nil, nil)
+	w := test.NewWindow(entry)
+	defer w.Close()
+
+	// Entry no longer being edited: no focus
+	table.focusEditEntry(entry, true)
+	if w.Canvas().Focused() != nil {
+		t.Error("Expected stale edit entry not to be focused")
+	}
+
+	table.editingEntry = entry
+	table.focusEditEntry(entry, true)
+	if w.Canvas().Focused() != entry {
+		t.Error("Expected edit entry to be focused")
+	}
+	if entry.SelectedText() != "apple" {
+		t.Errorf("Expected entry text to be selected, got %q", entry.SelectedText())
+	}
+}
+
+// TestTypeToEdit tests that typing a character on a selected editable cell starts an edit with that character
+func TestTypeToEdit(t *testing.T) {
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "text", Title: "Text", Editable: true},
+		{ID: "check", Title: "Check", Editable: true, ShowCheckbox: true},
+		{ID: "popup", Title: "Popup", Editable: true, PopupOptions: func(interface{}) []string { return []string{"a"} }},
+		{ID: "fixed", Title: "Fixed"},
+	}
+	table := createTestTable(config)
+	table.SetData([]interface{}{"apple", "banana"})
+
+	// Runes arrive through the wrapped widget.Table
+	forwarder := &keyboardForwardingTable{onTypedRune: table.TypedRune}
+
+	// Non-editable, checkbox and popup cells ignore runes
+	for col := 1; col <= 3; col++ {
+		table.SetSelectedCell(0, col)
+		forwarder.TypedRune('x')
+		if table.state.IsEditing() {
+			t.Errorf("Expected rune to be ignored on column %d", col)
+			table.cancelEdit()
+		}
+	}
+
+	// Space is left to the activate-cell key handling
+	table.SetSelectedCell(1, 0)
+	forwarder.TypedRune(' ')
+	if table.state.IsEditing() {
+		t.Fatal("Expected space rune not to start an edit")
+	}
+
+	// A printable rune starts an edit that replaces the value
+	forwarder.TypedRune('k')
+	if table.state.editingRow != 1 || table.state.editingCol != 0 {
+		t.Fatalf("Expected edit on (1,0), got (%d,%d)", table.state.editingRow, table.state.editingCol)
+	}
+	if table.state.GetEditingValue() != "banana" {
+		t.Errorf("Expected original value 'banana' kept, got %q", table.state.GetEditingValue())
+	}
+	table.renderDataCell(0, 1, container.NewStack())
+	if table.editingEntry.Text != "k" || table.editingEntry.CursorColumn != 1 {
+		t.Errorf("Expected entry 'k' with caret after it, got %q at %d", table.editingEntry.Text, table.editingEntry.CursorColumn)
+	}
+
+	// Runes while editing go to the entry, not a new edit
+	forwarder.TypedRune('z')
+	if table.editingEntry.Text != "k" {
+		t.Errorf("Expected edit in progress to be untouched, got %q", table.editingEntry.Text)
+	}
+
+	// The next normal edit shows the current value again
+	table.cancelEdit()
+	table.startEdit(1, 0)
+	table.renderDataCell(0, 1, container.NewStack())
+	if table.editingEntry.Text != "banana" {
+		t.Errorf("Expected 'banana' for Enter/Space edit, got %q", table.editingEntry.Text)
+	}
+	table.cancelEdit()
+
+	// Disabled via config
+	config.TypeToEdit = false
+	forwarder.TypedRune('k')
+	if table.state.IsEditing() {
+		t.Error("Expected TypeToEdit=false to ignore runes")
+	}
+}
+
 // TestCancelEdit tests canceling an edit operation
 func TestCancelEdit(t *testing.T) {
 	config := NewConfig("test")
@@ -264,6 +632,96 @@ func TestCancelEdit(t *testing.T) {
 	}
 }
 
+// TestEditTypes tests the number, check and select box editors of EditType columns
+func TestEditTypes(t *testing.T) {
+	test.NewTempApp(t)
+
+	var edits []string
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "Priority", Title: "Priority", Editable: true, EditType: EditNumber},
+		{ID: "Active", Title: "Active", Editable: true, EditType: EditBool},
+		{ID: "Status", Title: "Status", Editable: true, EditType: EditSelect,
+			PopupOptions: func(data interface{}) []string { return []string{"Active", "Inactive", "Pending"} }},
+	}
+	config.OnCellEdited = func(rowIndex int, colID string, newValue string, data interface{}) {
+		edits = append(edits, fmt.Sprintf("%d %s=%s", rowIndex, colID, newValue))
+	}
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	// Space starts an edit of the selected cell, rendered with the column's editor
+	edit := func(row, col int) fyne.CanvasObject {
+		t.Helper()
+		table.SetSelectedCell(row, col)
+		table.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace})
+		if !table.state.IsEditing() {
+			t.Fatalf("Expected Space to start editing column %d", col)
+		}
+		cell := container.NewStack()
+		table.renderDataCell(col, row, cell)
+		return cell.Objects[0]
+	}
+	expectEdits := func(action string, want ...string) {
+		t.Helper()
+		if !slices.Equal(edits, want) {
+			t.Errorf("%s: expected edits %q, got %q", action, want, edits)
+		}
+		edits = nil
+	}
+
+	// Number: text that isn't a number is rejected and the edit stays open
+	entry := edit(0, 0).(*escapeableEntry)
+	entry.SetText("high")
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	if !table.state.IsEditing() || entry.Validate() == nil {
+		t.Error("Expected a non-numeric value to keep the edit open with a validation error")
+	}
+	expectEdits("non-numeric entry")
+	entry.SetText(" 2.5 ")
+	entry.TypedKey(&fyne.KeyEvent{Name: fyne.KeyReturn})
+	expectEdits("numeric entry", "0 Priority=2.5")
+	for _, text := range []string{"NaN", "Inf", "-infinity", "0x1p-2", "1e400", "1.2.3", ""} {
+		if validateNumber(text) == nil {
+			t.Errorf("Expected %q to be rejected as a number", text)
+		}
+	}
+	for _, text := range []string{"42", "-3", "+.5", "2.", "1e-3", "6.02E23"} {
+		if err := validateNumber(text); err != nil {
+			t.Errorf("Expected %q to be accepted as a number, got %v", text, err)
+		}
+	}
+
+	// Bool: a check starting from the value, saved when toggled
+	check := edit(0, 1).(*widget.Check)
+	if !check.Checked {
+		t.Error("Expected the check to start checked for Alice")
+	}
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace})
+	expectEdits("toggle", "0 Active=false")
+
+	// Select: the PopupOptions, saved when one is chosen; Escape cancels. The
+	// select box is built when the edit starts, not while rendering.
+	table.SetSelectedCell(3, 2)
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace})
+	if _, ok := table.editingWidget.(*widget.Select); !ok {
+		t.Errorf("Expected startEdit to build the select box, got %T", table.editingWidget)
+	}
+	table.cancelEdit()
+	sel := edit(3, 2).(*widget.Select)
+	if sel.Selected != "Pending" || len(sel.Options) != 3 {
+		t.Errorf("Expected select of 3 options on 'Pending', got %q of %v", sel.Selected, sel.Options)
+	}
+	sel.SetSelected("Active")
+	expectEdits("select", "3 Status=Active")
+	edit(3, 2)
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyEscape})
+	if table.state.IsEditing() {
+		t.Error("Expected Escape to cancel the select edit")
+	}
+	expectEdits("escape")
+}
+
 // TestHandleCellClick tests cell click handling for selection
 func TestHandleCellClick(t *testing.T) {
 	config := NewConfig("test")
@@ -311,3 +769,1753 @@ func TestHandleCellClick(t *testing.T) {
 		t.Errorf("Expected selectedRow unchanged at %d, got %d", prevRow, table.state.selectedRow)
 	}
 }
+
+// TestMultiSelectClickModifiers tests plain click replacing and Ctrl/Cmd-click toggling the selection
+func TestMultiSelectClickModifiers(t *testing.T) {
+	var modifiers fyne.KeyModifier
+	original := currentKeyModifiers
+	currentKeyModifiers = func() fyne.KeyModifier { return modifiers }
+	defer func() { currentKeyModifiers = original }()
+
+	config := createTestConfig()
+	config.AllowMultiSelect = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+
+	click := func(row int) { table.handleCellClick(widget.TableCellID{Row: row + 1, Col: 0}) }
+	assertRows := func(want string) {
+		t.Helper()
+		if got := fmt.Sprint(table.GetSelectedRows()); got != want {
+			t.Errorf("Expected selected rows %s, got %s", want, got)
+		}
+	}
+
+	// Plain clicks replace the selection
+	click(0)
+	click(2)
+	assertRows("[2]")
+
+	// Ctrl-click and Cmd-click add rows, and toggle them off again
+	modifiers = fyne.KeyModifierControl
+	click(3)
+	modifiers = fyne.KeyModifierSuper
+	click(4)
+	assertRows("[2 3 4]")
+	click(3)
+	assertRows("[2 4]")
+
+	// A plain click collapses back to one row
+	modifiers = 0
+	click(1)
+	assertRows("[1]")
+
+	// With PlainClickReplacesSelection off, every click toggles
+	config.PlainClickReplacesSelection = false
+	click(0)
+	click(1)
+	assertRows("[0]")
+}
+
+// TestMultiSelectShiftClickRange tests Shift-click selecting the visible rows from the anchor row
+func TestMultiSelectShiftClickRange(t *testing.T) {
+	var modifiers fyne.KeyModifier
+	original := currentKeyModifiers
+	currentKeyModifiers = func() fyne.KeyModifier { return modifiers }
+	defer func() { currentKeyModifiers = original }()
+
+	config := createTestConfig()
+	config.AllowMultiSelect = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+
+	click := func(displayRow int) { table.handleCellClick(widget.TableCellID{Row: displayRow + 1, Col: 0}) }
+	assertRows := func(want string) {
+		t.Helper()
+		if got := fmt.Sprint(table.GetSelectedRows()); got != want {
+			t.Errorf("Expected selected rows %s, got %s", want, got)
+		}
+	}
+
+	// Anchor, then Shift-click three rows later selects all four
+	click(0)
+	modifiers = fyne.KeyModifierShift
+	click(3)
+	assertRows("[0 1 2 3]")
+
+	// The anchor stays put: another Shift-click re-ranges from the same row
+	click(4)
+	assertRows("[0 1 2 3 4]")
+
+	// Ctrl/Cmd+Shift adds the range to the selection
+	modifiers = 0
+	click(0)
+	modifiers = fyne.KeyModifierControl
+	click(4)
+	click(3)
+	modifiers = fyne.KeyModifierControl | fyne.KeyModifierShift
+	click(1)
+	assertRows("[0 1 2 3 4]")
+
+	// Filtered-out rows in between aren't selected
+	table.SetFilter("active", false) // Alice, Bob (Inactive), Charlie, David
+	modifiers = 0
+	click(0) // Alice
+	modifiers = fyne.KeyModifierShift
+	click(3) // David
+	assertRows("[0 1 2 4]")
+
+	// Without a visible anchor, Shift-click acts as a plain click
+	table.SetFilter("", false)
+	table.ClearSelection()
+	click(2)
+	assertRows("[2]")
+}
+
+// TestOnSelectionChanged tests OnSelectionChanged firing once per selection change with the full selection
+func TestOnSelectionChanged(t *testing.T) {
+	var modifiers fyne.KeyModifier
+	original := currentKeyModifiers
+	currentKeyModifiers = func() fyne.KeyModifier { return modifiers }
+	defer func() { currentKeyModifiers = original }()
+
+	var calls []string
+	config := createTestConfig()
+	config.AllowMultiSelect = true
+	config.OnSelectionChanged = func(selectedRows []int, data []interface{}) {
+		var names []string
+		for _, item := range data {
+			names = append(names, item.(TestData).Name)
+		}
+		calls = append(calls, fmt.Sprint(selectedRows, names))
+	}
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+
+	click := func(row int) { table.handleCellClick(widget.TableCellID{Row: row + 1, Col: 0}) }
+	expect := func(action string, want ...string) {
+		t.Helper()
+		if !slices.Equal(calls, want) {
+			t.Errorf("%s: expected calls %q, got %q", action, want, calls)
+		}
+		calls = nil
+	}
+
+	click(1)
+	expect("click", "[1] [Bob]")
+	click(1)
+	expect("click on the selected row")
+
+	modifiers = fyne.KeyModifierShift
+	click(3)
+	expect("Shift-click", "[1 2 3] [Bob Charlie alice]")
+	modifiers = 0
+
+	table.TypedShortcut(&fyne.ShortcutSelectAll{})
+	expect("Ctrl+A", "[0 1 2 3 4] [Alice Bob Charlie alice David]")
+
+	table.SetSelectedRows([]int{4, 0})
+	expect("SetSelectedRows", "[0 4] [Alice David]")
+
+	// Programmatic re-selection of the same row doesn't report a change
+	table.SetSelectedRows([]int{2})
+	calls = nil
+	table.state.isReselecting = true
+	click(2)
+	table.state.isReselecting = false
+	expect("re-selection")
+
+	table.ClearSelection()
+	expect("ClearSelection", "[] []")
+}
+
+// TestHighlightFullRow tests the row tint plus active cell highlight in row-column mode
+func TestHighlightFullRow(t *testing.T) {
+	config := createTestConfig()
+	config.RowSelectOnlyMode = false
+	config.HighlightFullRow = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.SetSelectedCell(1, 2)
+
+	render := func(col, row int) fyne.CanvasObject {
+		cell := container.NewStack(widget.NewLabel(""))
+		table.renderDataCell(col, row, cell)
+		return cell.Objects[0]
+	}
+	background := func(obj fyne.CanvasObject) color.Color {
+		stack, ok := obj.(*fyne.Container)
+		if !ok {
+			return nil
+		}
+		return stack.Objects[0].(*canvas.Rectangle).FillColor
+	}
+
+	// Active cell: full selection color (with border)
+	if bg := background(render(2, 1)); bg != theme.Color(theme.ColorNameSelection) {
+		t.Errorf("Expected active cell selection background, got %v", bg)
+	}
+	// Other cells in the row: lighter tint
+	for _, col := range []int{0, 1, 3} {
+		if bg := background(render(col, 1)); bg != rowTintColor() {
+			t.Errorf("Expected row tint on column %d, got %v", col, bg)
+		}
+	}
+	// Other rows: no background
+	if bg := background(render(0, 0)); bg != nil {
+		t.Errorf("Expected unselected row to be plain, got %v", bg)
+	}
+
+	// Without the option only the active cell is highlighted
+	config.HighlightFullRow = false
+	if bg := background(render(0, 1)); bg != nil {
+		t.Errorf("Expected no row tint with HighlightFullRow off, got %v", bg)
+	}
+	// Row-only mode already highlights every cell fully
+	config.HighlightFullRow = true
+	config.RowSelectOnlyMode = true
+	if bg := background(render(0, 1)); bg != theme.Color(theme.ColorNameSelection) {
+		t.Errorf("Expected full selection background in row-only mode, got %v", bg)
+	}
+}
+
+// TestTabNavigation tests Tab moving between cells and releasing focus at the end
+func TestTabNavigation(t *testing.T) {
+	config := NewConfig("test")
+	config.RowSelectOnlyMode = false
+	config.Columns = []ColumnConfig{
+		{ID: "col1", Title: "Column 1"},
+		{ID: "col2", Title: "Column 2"},
+	}
+
+	table := &Table{
+		config:     config,
+		data:       []interface{}{"apple", "banana"},
+		KeyHandler: NewDefaultKeyHandler(),
+		state: &TableState{
+			selectedRow:    0,
+			selectedCol:    0,
+			editingRow:     -1,
+			editingCol:     -1,
+			visibleColumns: []int{0, 1},
+			visibleRows:    []int{0, 1},
+		},
+	}
+
+	tab := &fyne.KeyEvent{Name: fyne.KeyTab}
+
+	// Tab moves right, then wraps to the next row
+	table.TypedKey(tab)
+	if row, col := table.GetSelectedCell(); row != 0 || col != 1 {
+		t.Errorf("Expected (0, 1) after first Tab, got (%d, %d)", row, col)
+	}
+	table.TypedKey(tab)
+	if row, col := table.GetSelectedCell(); row != 1 || col != 0 {
+		t.Errorf("Expected (1, 0) after second Tab, got (%d, %d)", row, col)
+	}
+	if !table.AcceptsTab() {
+		t.Error("Expected table to accept Tab before the last cell")
+	}
+
+	// At the last cell Tab is released so focus can leave the table
+	table.TypedKey(tab)
+	if table.AcceptsTab() {
+		t.Error("Expected table to release Tab at the last cell")
+	}
+	table.TypedKey(tab)
+	if row, col := table.GetSelectedCell(); row != 1 || col != 1 {
+		t.Errorf("Expected selection to stay at (1, 1), got (%d, %d)", row, col)
+	}
+
+	// Shift+Tab direction walks backwards across the row boundary
+	if row, col, ok := table.nextTabCell(false); !ok || row != 1 || col != 0 {
+		t.Errorf("Expected backward Tab target (1, 0), got (%d, %d, %v)", row, col, ok)
+	}
+
+	// TabMovesFocusOut always releases Tab
+	table.state.selectedRow, table.state.selectedCol = 0, 0
+	config.TabMovesFocusOut = true
+	if table.AcceptsTab() {
+		t.Error("Expected TabMovesFocusOut to release Tab")
+	}
+}
+
+// TestFocusHooksAndFocusNext tests the focus callbacks and Tab routing to Config.FocusNext
+func TestFocusHooksAndFocusNext(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	config.RowSelectOnlyMode = false
+	next := widget.NewEntry()
+	config.FocusNext = next
+	gained, lost := 0, 0
+	config.OnFocusGained = func() { gained++ }
+	config.OnFocusLost = func() { lost++ }
+
+	table := NewTable(config)
+	table.SetData(createTestData())
+	w := test.NewWindow(container.NewVBox(table, next))
+	defer w.Close()
+
+	w.Canvas().Focus(table.table)
+	if gained != 1 || !table.state.hasFocus {
+		t.Errorf("Expected OnFocusGained once, got %d", gained)
+	}
+
+	// Tab inside the table moves between cells
+	table.SetSelectedCell(0, 0)
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyTab})
+	if w.Canvas().Focused() != table.table {
+		t.Error("Expected Tab before the last cell to stay in the table")
+	}
+
+	// Tab at the last cell goes to FocusNext
+	last := table.state.visibleColumns[len(table.state.visibleColumns)-1]
+	table.SetSelectedCell(4, last)
+	if !table.AcceptsTab() {
+		t.Error("Expected table to take Tab so it can route focus to FocusNext")
+	}
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyTab})
+	if w.Canvas().Focused() != next {
+		t.Errorf("Expected FocusNext focused after tabbing out, got %v", w.Canvas().Focused())
+	}
+	if lost != 1 || table.state.hasFocus {
+		t.Errorf("Expected OnFocusLost once, got %d", lost)
+	}
+
+	// TabMovesFocusOut leaves from any cell
+	config.TabMovesFocusOut = true
+	w.Canvas().Focus(table.table)
+	table.SetSelectedCell(0, 0)
+	table.TypedKey(&fyne.KeyEvent{Name: fyne.KeyTab})
+	if w.Canvas().Focused() != next {
+		t.Error("Expected TabMovesFocusOut to route focus to FocusNext")
+	}
+}
+
+// TestActivateOnSingleClick tests that single-click activation of interactive cells can be disabled
+func TestActivateOnSingleClick(t *testing.T) {
+	for _, activate := range []bool{true, false} {
+		config := NewConfig("test")
+		config.ActivateOnSingleClick = activate
+
+		toggles := 0
+		config.Columns = []ColumnConfig{
+			{
+				ID:               "done",
+				Title:            "Done",
+				ShowCheckbox:     true,
+				GetCheckboxValue: func(data interface{}) bool { return false },
+				OnCheckboxChanged: func(data interface{}, checked bool, rowIndex int) {
+					toggles++
+				},
+			},
+		}
+
+		table := &Table{
+			config:       config,
+			data:         []interface{}{"apple"},
+			MouseHandler: NewDefaultMouseHandler(),
+			state: &TableState{
+				selectedRow:    -1,
+				selectedCol:    -1,
+				visibleColumns: []int{0},
+				visibleRows:    []int{0},
+			},
+		}
+		table.table = &keyboardForwardingTable{Table: &widget.Table{}}
+
+		table.handleCellClick(widget.TableCellID{Row: 1, Col: 0})
+
+		if table.state.selectedRow != 0 {
+			t.Errorf("ActivateOnSingleClick=%v: expected row 0 selected, got %d", activate, table.state.selectedRow)
+		}
+		wantToggles := 0
+		if activate {
+			wantToggles = 1
+		}
+		if toggles != wantToggles {
+			t.Errorf("ActivateOnSingleClick=%v: expected %d checkbox toggles, got %d", activate, wantToggles, toggles)
+		}
+	}
+}
+
+// TestSampleWidestCells tests that auto-resize sampling keeps the widest-looking cells
+func TestSampleWidestCells(t *testing.T) {
+	cells := []cellText{
+		{text: "a"},
+		{text: "abcdef"},
+		{text: "abc"},
+		{text: "ab", extra: 100}, // Short text but wide tree decoration
+		{text: "abcd"},
+	}
+
+	sample := sampleWidestCells(cells, 10, 2)
+	if len(sample) != 2 {
+		t.Fatalf("Expected 2 sampled cells, got %d", len(sample))
+	}
+	if sample[0].text != "ab" || sample[1].text != "abcdef" {
+		t.Errorf("Expected samples [ab abcdef], got [%s %s]", sample[0].text, sample[1].text)
+	}
+
+	if all := sampleWidestCells(cells, 10, 10); len(all) != len(cells) {
+		t.Errorf("Expected all %d cells when sample size exceeds count, got %d", len(cells), len(all))
+	}
+}
+
+// TestAutoResizeColumnFitsWidestCell tests that sampling still sizes the column to its widest cell
+func TestAutoResizeColumnFitsWidestCell(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	table := createTestTable(config)
+	data := make([]interface{}, 500)
+	for i := range data {
+		data[i] = TestData{ID: i, Name: fmt.Sprintf("Row %d", i)}
+	}
+	widest := strings.Repeat("W", 40)
+	data[321] = TestData{ID: 321, Name: widest}
+	table.SetData(data)
+
+	table.autoResizeColumn(1) // name
+	expected := fyne.MeasureText(widest, theme.TextSize(), fyne.TextStyle{}).Width + 20
+	if got := table.config.Columns[1].Width; got < expected {
+		t.Errorf("Expected column width >= %v, got %v", expected, got)
+	}
+}
+
+// TestResizeStripWithoutHeaders tests dragging and double-clicking dividers on the headerless resize strip
+func TestResizeStripWithoutHeaders(t *testing.T) {
+	test.NewTempApp(t)
+
+	// With headers shown, the header row provides resizing: no strip
+	config := createTestConfig()
+	config.EnableManualResize = true
+	if NewTable(config).resizeStrip != nil {
+		t.Error("Expected no resize strip when headers are shown")
+	}
+
+	config = createTestConfig()
+	config.ShowHeaders = false
+	config.EnableManualResize = true
+	config.Columns[0].MinWidth = 30
+	var saved map[string]float32
+	config.SaveColumnWidths = func(widths map[string]float32) { saved = widths }
+	table := NewTable(config)
+	table.SetData(createTestData())
+	strip := table.resizeStrip
+	if strip == nil {
+		t.Fatal("Expected resize strip with headers hidden")
+	}
+
+	// One divider mark per visible column
+	renderer := test.TempWidgetRenderer(t, strip)
+	renderer.Layout(fyne.NewSize(400, resizeStripHeight))
+	if len(renderer.Objects()) != len(config.Columns) {
+		t.Errorf("Expected %d divider marks, got %d", len(config.Columns), len(renderer.Objects()))
+	}
+
+	// Drag the first divider (x=50) to the right, then past the minimum width
+	drag := func(x, dx float32) {
+		strip.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(x, 2)}, Dragged: fyne.NewDelta(dx, 0)})
+	}
+	drag(55, 5)
+	drag(80, 25)
+	if got := config.Columns[0].Width; got != 80 {
+		t.Errorf("Expected width 80 after drag, got %v", got)
+	}
+	if saved != nil {
+		t.Error("Expected widths to be saved only when the drag ends")
+	}
+	drag(0, -80)
+	strip.DragEnd()
+	if got := config.Columns[0].Width; got != 30 {
+		t.Errorf("Expected width clamped to MinWidth 30, got %v", got)
+	}
+	if saved["id"] != 30 {
+		t.Errorf("Expected saved width 30 for id, got %v", saved)
+	}
+
+	// Drags that don't start on a divider are ignored
+	drag(15, 5)
+	strip.DragEnd()
+	if got := config.Columns[0].Width; got != 30 {
+		t.Errorf("Expected width unchanged by drag inside a column, got %v", got)
+	}
+
+	// Double-click on the divider after "Name" (x=30+150) auto-resizes it
+	strip.DoubleTapped(&fyne.PointEvent{Position: fyne.NewPos(180, 2)})
+	if got := config.Columns[1].Width; got == 150 {
+		t.Error("Expected double-click to auto-resize the Name column")
+	}
+}
+
+// TestResizeStripFollowsLayout tests that strip dividers sit between the cells as
+// laid out by the table (with padding) and follow horizontal scrolling
+func TestResizeStripFollowsLayout(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	config.ShowHeaders = false
+	config.EnableManualResize = true
+	table := NewTable(config)
+	table.SetData(createTestData())
+	w := test.NewWindow(table)
+	defer w.Close()
+	w.Resize(fyne.NewSize(200, 300)) // Narrower than the columns, so it scrolls
+
+	checkDividers := func(when string) {
+		t.Helper()
+		marks := test.TempWidgetRenderer(t, table.resizeStrip).Objects()
+		for i, colIndex := range table.state.visibleColumns {
+			pos, size, ok := table.CellBounds(0, colIndex)
+			if !ok {
+				t.Fatalf("%s: expected bounds for column %d", when, colIndex)
+			}
+			// Each divider is centred in the padding after its column
+			want := pos.X + size.Width + theme.Padding()/2
+			if got := table.columnDividerOffsets()[i]; got != want {
+				t.Errorf("%s: expected divider %d at %v, got %v", when, i, want, got)
+			}
+			markX := marks[i].Position().X + marks[i].Size().Width/2
+			if markX != want {
+				t.Errorf("%s: expected mark %d at %v, got %v", when, i, want, markX)
+			}
+		}
+	}
+	checkDividers("unscrolled")
+
+	content := table.tableInternal("content").Interface().(*container.Scroll)
+	content.Scrolled(&fyne.ScrollEvent{Scrolled: fyne.NewDelta(-60, 0)})
+	if table.scrollOffset().X != 60 {
+		t.Fatalf("Expected table scrolled by 60, got %v", table.scrollOffset())
+	}
+	checkDividers("scrolled")
+
+	// A drag on the scrolled "Name" divider resizes "Name"
+	x := table.columnDividerOffsets()[1]
+	table.resizeStrip.Dragged(&fyne.DragEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos(x+10, 2)}, Dragged: fyne.NewDelta(10, 0)})
+	table.resizeStrip.DragEnd()
+	if got := config.Columns[1].Width; got != 160 {
+		t.Errorf("Expected Name width 160 after drag, got %v", got)
+	}
+}
+
+// TestOnColumnResized tests that header drags, strip drags and auto-resize all report and save widths
+func TestOnColumnResized(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	var resized []string
+	config.OnColumnResized = func(columnID string, newWidth float32) {
+		resized = append(resized, fmt.Sprintf("%s=%.0f", columnID, newWidth))
+	}
+	saves := 0
+	config.SaveColumnWidths = func(map[string]float32) { saves++ }
+	table := NewTable(config)
+	table.SetData(createTestData())
+
+	// A header drag only updates Fyne's widths; the end of the drag reports it
+	table.table.SetColumnWidth(1, 200)
+	table.table.DragEnd()
+	if strings.Join(resized, ",") != "name=200" || saves != 1 {
+		t.Errorf("Expected name=200 reported and saved once, got %v (saves=%d)", resized, saves)
+	}
+	if config.Columns[1].Width != 200 {
+		t.Errorf("Expected config width synced to 200, got %v", config.Columns[1].Width)
+	}
+
+	// Drags that don't resize anything (e.g. scrolling) report nothing
+	table.table.DragEnd()
+	if len(resized) != 1 || saves != 1 {
+		t.Errorf("Expected no report for a drag without resize, got %v (saves=%d)", resized, saves)
+	}
+
+	// Auto-resize reports too
+	table.autoResizeColumn(2)
+	if len(resized) != 2 || !strings.HasPrefix(resized[1], "status=") || saves != 2 {
+		t.Errorf("Expected auto-resize of status to be reported, got %v (saves=%d)", resized, saves)
+	}
+}
+
+// BenchmarkAutoResizeColumn measures auto-fit on a large table
+func BenchmarkAutoResizeColumn(b *testing.B) {
+	test.NewTempApp(b)
+
+	config := createTestConfig()
+	table := createTestTable(config)
+	data := make([]interface{}, 10000)
+	for i := range data {
+		data[i] = TestData{ID: i, Name: fmt.Sprintf("Name %d", i), Status: "Active"}
+	}
+	table.SetData(data)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		table.autoResizeColumn(1)
+	}
+}
+
+// TestNumberFormatter tests number display formatting
+func TestNumberFormatter(t *testing.T) {
+	tests := []struct {
+		name         string
+		decimals     int
+		thousandsSep bool
+		value        interface{}
+		expected     string
+	}{
+		{"float with separator", 2, true, 1234.5, "1,234.50"},
+		{"float without separator", 1, false, 1234.56, "1234.6"},
+		{"int with separator", 0, true, 1234567, "1,234,567"},
+		{"negative", 2, true, -9876.543, "-9,876.54"},
+		{"small number", 0, true, 12, "12"},
+		{"non-numeric", 2, true, "n/a", "n/a"},
+		{"nil", 2, true, nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewNumberFormatter(tt.decimals, tt.thousandsSep)(tt.value)
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestDateFormatter tests date display formatting
+func TestDateFormatter(t *testing.T) {
+	format := NewDateFormatter("2006-01-02")
+	date := time.Date(2024, time.March, 5, 14, 30, 0, 0, time.UTC)
+
+	if got := format(date); got != "2024-03-05" {
+		t.Errorf("Expected '2024-03-05', got %q", got)
+	}
+	if got := format(&date); got != "2024-03-05" {
+		t.Errorf("Expected '2024-03-05' for pointer, got %q", got)
+	}
+	if got := format(time.Time{}); got != "" {
+		t.Errorf("Expected blank for zero time, got %q", got)
+	}
+}
+
+// TestFormatterDisplayVsSort tests that formatters change display text but not sorting or filtering
+// TestBoolDisplay tests the display variants for bool fields
+func TestBoolDisplay(t *testing.T) {
+	type item struct {
+		Name     string
+		External bool
+	}
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "name", Title: "Name"},
+		{ID: "external", Title: "External", Sortable: true},
+	}
+	config.FilterColumns = []string{"external"}
+	table := createTestTable(config)
+	table.SetData([]interface{}{item{"a", true}, item{"b", false}, item{"c", true}})
+
+	tests := []struct {
+		name       string
+		display    BoolDisplay
+		boolAsIcon bool
+		yes, no    string
+	}{
+		{"default", BoolDisplayDefault, false, "true", "false"},
+		{"default with BoolAsIcon", BoolDisplayDefault, true, "✓", "✗"},
+		{"text overrides BoolAsIcon", BoolDisplayText, true, "true", "false"},
+		{"icon", BoolDisplayIcon, false, "✓", "✗"},
+		{"yes/no", BoolDisplayYesNo, false, "Yes", "No"},
+		{"on/off", BoolDisplayOnOff, false, "On", "Off"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.BoolAsIcon = tt.boolAsIcon
+			config.Columns[1].BoolDisplay = tt.display
+			if got := table.displayValue(item{External: true}, config.Columns[1]); got != tt.yes {
+				t.Errorf("Expected %q for true, got %q", tt.yes, got)
+			}
+			if got := table.displayValue(item{External: false}, config.Columns[1]); got != tt.no {
+				t.Errorf("Expected %q for false, got %q", tt.no, got)
+			}
+		})
+	}
+
+	// Non-bool fields are unaffected
+	config.BoolAsIcon = true
+	config.Columns[1].BoolDisplay = BoolDisplayDefault
+	if got := table.displayValue(item{Name: "true"}, config.Columns[0]); got != "true" {
+		t.Errorf("Expected non-bool field shown as-is, got %q", got)
+	}
+
+	// The renderer shows the icon; screen readers hear words
+	cell := container.NewStack(widget.NewLabel(""))
+	table.renderDataCell(1, 0, cell)
+	if label, ok := cell.Objects[0].(*widget.Label); !ok || label.Text != "✓" {
+		t.Errorf("Expected rendered label '✓', got %#v", cell.Objects[0])
+	}
+	if got := table.accessibleCellValue(item{External: false}, config.Columns[1]); got != "No" {
+		t.Errorf("Expected accessible value 'No', got %q", got)
+	}
+
+	// Filtering and sorting still use the bool
+	table.SetFilter("true", false)
+	if got := table.GetVisibleRowCount(); got != 2 {
+		t.Errorf("Expected filter on the underlying bool to match 2 rows, got %d", got)
+	}
+	table.SetFilter("", false)
+	table.Sort("external", true)
+	if table.data[0].(item).External {
+		t.Error("Expected false to sort before true")
+	}
+}
+
+func TestFormatterDisplayVsSort(t *testing.T) {
+	type item struct {
+		Amount float64
+	}
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "amount", Title: "Amount", Sortable: true, Comparator: NewNumericComparator("amount"), Formatter: NewNumberFormatter(2, true)},
+	}
+	config.FilterColumns = []string{"amount"}
+	table := createTestTable(config)
+	table.SetData([]interface{}{item{Amount: 1234.5}, item{Amount: 99}, item{Amount: 100000}})
+
+	// Display goes through the formatter
+	if got := table.displayValue(table.data[0], config.Columns[0]); got != "1,234.50" {
+		t.Errorf("Expected display '1,234.50', got %q", got)
+	}
+	cell := container.NewStack(widget.NewLabel(""))
+	table.renderDataCell(0, 0, cell)
+	if label, ok := cell.Objects[0].(*widget.Label); !ok || label.Text != "1,234.50" {
+		t.Errorf("Expected rendered label '1,234.50', got %#v", cell.Objects[0])
+	}
+
+	// Sorting uses the raw numeric value (a string sort of the display text would put "1,234.50" first)
+	if err := table.Sort("amount", true); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	for i, expected := range []float64{99, 1234.5, 100000} {
+		if got := table.data[i].(item).Amount; got != expected {
+			t.Errorf("Row %d: expected %v, got %v", i, expected, got)
+		}
+	}
+
+	// Filtering matches the raw value, not the formatted text
+	table.SetFilter("1234.5", false)
+	if len(table.state.visibleRows) != 1 {
+		t.Errorf("Expected raw value filter to match 1 row, got %d", len(table.state.visibleRows))
+	}
+}
+
+// TestSortKey tests sorting by a column's sort key while the display shows other text
+func TestSortKey(t *testing.T) {
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "name", Title: "Name", Sortable: true, SortKey: func(data interface{}) string {
+			return data.(map[string]interface{})["last"].(string)
+		}},
+	}
+	table := createTestTable(config)
+	table.SetData([]interface{}{
+		map[string]interface{}{"name": "Ann Young", "last": "Young"},
+		map[string]interface{}{"name": "Zed Adams", "last": "Adams"},
+		map[string]interface{}{"name": "Bob Miller", "last": "Miller"},
+	})
+
+	if err := table.Sort("name", true); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	// Sorted by last name, displayed first name first
+	for i, expected := range []string{"Zed Adams", "Bob Miller", "Ann Young"} {
+		if got := table.displayValue(table.data[i], config.Columns[0]); got != expected {
+			t.Errorf("Row %d: expected %q, got %q", i, expected, got)
+		}
+	}
+
+	// An explicit comparator takes precedence over the sort key
+	config.Columns[0].Comparator = NewStringComparator("name")
+	table.Sort("name", true)
+	if got := table.displayValue(table.data[0], config.Columns[0]); got != "Ann Young" {
+		t.Errorf("Expected comparator to sort by display name, got %q first", got)
+	}
+}
+
+// TestNilsLast tests that blank values stay at the end (or start) in both sort directions
+func TestNilsLast(t *testing.T) {
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{{ID: "name", Title: "Name", Sortable: true}}
+	table := createTestTable(config)
+	table.SetData([]interface{}{
+		map[string]interface{}{"name": "Bob"},
+		map[string]interface{}{"name": ""},
+		map[string]interface{}{"name": "Amy"},
+		map[string]interface{}{"name": nil},
+		map[string]interface{}{},
+		map[string]interface{}{"name": "Cal"},
+	})
+	names := func() string {
+		var result []string
+		for _, item := range table.data {
+			result = append(result, table.extractFieldValue(item, "name"))
+		}
+		return strings.Join(result, ",")
+	}
+
+	config.Columns[0].Comparator = NilsLast("name", nil)
+	table.Sort("name", true)
+	if got := names(); got != "Amy,Bob,Cal,,," {
+		t.Errorf("Ascending: expected blanks last, got %q", got)
+	}
+	table.Sort("name", false)
+	if got := names(); got != "Cal,Bob,Amy,,," {
+		t.Errorf("Descending: expected blanks last, got %q", got)
+	}
+
+	config.Columns[0].Comparator = NilsFirst("name", NewStringComparator("name"))
+	table.Sort("name", true)
+	if got := names(); got != ",,,Amy,Bob,Cal" {
+		t.Errorf("Ascending: expected blanks first, got %q", got)
+	}
+	table.Sort("name", false)
+	if got := names(); got != ",,,Cal,Bob,Amy" {
+		t.Errorf("Descending: expected blanks first, got %q", got)
+	}
+
+	// Zero numbers count as empty; inner orders the rest
+	cmp := NilsLast("n", NewNumericComparator("n"))
+	if cmp(map[string]interface{}{"n": 0}, map[string]interface{}{"n": 5}) != SortLast {
+		t.Error("Expected zero to sort last")
+	}
+	if cmp(map[string]interface{}{"n": 2}, map[string]interface{}{"n": 5}) >= 0 {
+		t.Error("Expected inner comparator for non-empty values")
+	}
+}
+
+// TestNewBoolComparator tests that false sorts before true, for bool fields and strings
+func TestNewBoolComparator(t *testing.T) {
+	type flag bool
+	cmp := NewBoolComparator("External")
+
+	tests := []struct {
+		name string
+		a, b interface{}
+		want int
+	}{
+		{"bool field", struct{ External bool }{false}, struct{ External bool }{true}, -1},
+		{"named bool type", struct{ External flag }{true}, struct{ External flag }{false}, 1},
+		{"equal", struct{ External bool }{true}, struct{ External bool }{true}, 0},
+		{"strings", map[string]interface{}{"External": "true"}, map[string]interface{}{"External": "false"}, 1},
+		{"nil counts as false", map[string]interface{}{"External": nil}, map[string]interface{}{"External": false}, 0},
+		{"missing field last", TestData{}, map[string]interface{}{"External": true}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cmp(tt.a, tt.b); got != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{{ID: "active", Title: "Active", Sortable: true, Comparator: NewBoolComparator("active")}}
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.Sort("active", false)
+	var names []string
+	for _, item := range table.data {
+		names = append(names, item.(TestData).Name)
+	}
+	if got := strings.Join(names, ","); got != "Alice,Charlie,David,Bob,alice" {
+		t.Errorf("Expected active rows first, got %q", got)
+	}
+}
+
+// TestNewCaseInsensitiveStringComparator tests that case is ignored when comparing
+func TestNewCaseInsensitiveStringComparator(t *testing.T) {
+	cmp := NewCaseInsensitiveStringComparator("Name")
+	alice, upperAlice, bob := TestData{Name: "alice"}, TestData{Name: "Alice"}, TestData{Name: "BOB"}
+	if got := cmp(alice, upperAlice); got != 0 {
+		t.Errorf("Expected \"alice\" and \"Alice\" to be equal, got %d", got)
+	}
+	if got := cmp(bob, alice); got <= 0 {
+		t.Errorf("Expected \"BOB\" after \"alice\", got %d", got)
+	}
+	if got := NewStringComparator("Name")(bob, alice); got >= 0 {
+		t.Errorf("Expected the case-sensitive comparator to put \"BOB\" first, got %d", got)
+	}
+
+	// Equal rows keep their order
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{{ID: "name", Title: "Name", Sortable: true, Comparator: cmp}}
+	table := createTestTable(config)
+	table.SetData([]interface{}{bob, upperAlice, alice, TestData{Name: "carol"}})
+	table.Sort("name", true)
+	var names []string
+	for _, item := range table.data {
+		names = append(names, item.(TestData).Name)
+	}
+	if got := strings.Join(names, ","); got != "Alice,alice,BOB,carol" {
+		t.Errorf("Expected case-insensitive order, got %q", got)
+	}
+}
+
+// TestNewDateComparator tests chronological sorting of time values and date strings,
+// with invalid dates last in both directions
+func TestNewDateComparator(t *testing.T) {
+	type deadline time.Time
+	day := func(d int) time.Time { return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC) }
+	dayPtr := func(d int) *time.Time { t := day(d); return &t }
+
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{{ID: "due", Title: "Due", Sortable: true, Comparator: NewDateComparator("due", "2006-1-2")}}
+	table := createTestTable(config)
+	table.SetData([]interface{}{
+		map[string]interface{}{"id": "jan10", "due": "2024-1-10"},
+		map[string]interface{}{"id": "bad", "due": "next week"},
+		map[string]interface{}{"id": "jan02", "due": "2024-01-02"},
+		map[string]interface{}{"id": "jan05", "due": day(5)},
+		map[string]interface{}{"id": "nil", "due": nil},
+		map[string]interface{}{"id": "jan03", "due": dayPtr(3)},
+		map[string]interface{}{"id": "zero", "due": time.Time{}},
+		map[string]interface{}{"id": "jan07", "due": deadline(day(7))},
+	})
+	ids := func() string {
+		var result []string
+		for _, item := range table.data {
+			result = append(result, item.(map[string]interface{})["id"].(string))
+		}
+		return strings.Join(result, ",")
+	}
+
+	table.Sort("due", true)
+	if got := ids(); got != "jan02,jan03,jan05,jan07,jan10,bad,nil,zero" {
+		t.Errorf("Ascending: expected dates in order then invalid ones, got %q", got)
+	}
+	table.Sort("due", false)
+	if got := ids(); got != "jan10,jan07,jan05,jan03,jan02,bad,nil,zero" {
+		t.Errorf("Descending: expected invalid dates still last, got %q", got)
+	}
+
+	// Default layout is RFC3339; time zones are taken into account
+	cmp := NewDateComparator("", "")
+	if got := cmp("2024-03-01T10:00:00+02:00", "2024-03-01T09:00:00Z"); got >= 0 {
+		t.Errorf("Expected 08:00Z before 09:00Z, got %d", got)
+	}
+	if got := cmp("2024-03-01", "2024-03-01T09:00:00Z"); got != SortLast {
+		t.Errorf("Expected date without time to be invalid for RFC3339, got %d", got)
+	}
+}
+
+// TestCombineComparators tests multi-key comparators with three-level tie-breaking
+func TestCombineComparators(t *testing.T) {
+	row := func(priority int, status, name string) map[string]interface{} {
+		return map[string]interface{}{"priority": priority, "status": status, "name": name}
+	}
+	cmp := CombineComparators(
+		NewNumericComparator("priority"),
+		nil, // Skipped
+		NewStringComparator("status"),
+		NewStringComparator("name"),
+	)
+
+	tests := []struct {
+		name string
+		a, b map[string]interface{}
+		want int
+	}{
+		{"first key decides", row(1, "b", "b"), row(2, "a", "a"), -1},
+		{"second key breaks tie", row(1, "b", "a"), row(1, "a", "b"), 1},
+		{"third key breaks tie", row(1, "a", "a"), row(1, "a", "b"), -1},
+		{"equal on every key", row(1, "a", "a"), row(1, "a", "a"), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cmp(tt.a, tt.b); got != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+
+	// Sorting with the combined comparator orders by every key in turn
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{{ID: "priority", Title: "Priority", Sortable: true, Comparator: cmp}}
+	table := createTestTable(config)
+	table.SetData([]interface{}{
+		row(2, "open", "Cy"), row(1, "open", "Bo"), row(1, "done", "Zed"),
+		row(1, "open", "Al"), row(2, "done", "Ed"),
+	})
+	table.Sort("priority", true)
+	var names []string
+	for _, item := range table.data {
+		names = append(names, item.(map[string]interface{})["name"].(string))
+	}
+	if got := strings.Join(names, ","); got != "Zed,Al,Bo,Ed,Cy" {
+		t.Errorf("Expected rows ordered by priority, status, name, got %q", got)
+	}
+
+	// Empty chains compare everything as equal; SortFirst/SortLast pass through
+	if CombineComparators()(row(1, "a", "a"), row(2, "b", "b")) != 0 {
+		t.Error("Expected an empty chain to compare as equal")
+	}
+	withNils := CombineComparators(NilsLast("name", nil), NewNumericComparator("priority"))
+	if withNils(row(1, "a", ""), row(2, "a", "b")) != SortLast {
+		t.Error("Expected SortLast from the first comparator to pass through")
+	}
+}
+
+// TestMapRows tests rendering, sorting and filtering with map-based rows
+func TestMapRows(t *testing.T) {
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "name", Title: "Name", Sortable: true},
+		{ID: "age", Title: "Age", Sortable: true, Comparator: NewNumericComparator("age")},
+		{ID: "city", Title: "City"},
+	}
+	config.FilterColumns = []string{"name", "city"}
+	table := createTestTable(config)
+	table.SetData([]interface{}{
+		map[string]interface{}{"name": "Carol", "age": 35, "city": "Oslo"},
+		map[string]interface{}{"Name": "alice", "Age": 7}, // Different key case, missing city
+		map[string]string{"name": "Bob", "age": "12", "city": "Lima"},
+	})
+
+	// Rendering looks up the column ID as a key
+	if got := table.extractFieldValue(table.data[0], "name"); got != "Carol" {
+		t.Errorf("Expected 'Carol', got %q", got)
+	}
+	if got := table.extractFieldValue(table.data[1], "name"); got != "alice" {
+		t.Errorf("Expected case-insensitive key match 'alice', got %q", got)
+	}
+	if got := table.extractFieldValue(table.data[1], "city"); got != "" {
+		t.Errorf("Expected blank for missing key, got %q", got)
+	}
+	cell := container.NewStack(widget.NewLabel(""))
+	table.renderDataCell(2, 2, cell)
+	if label, ok := cell.Objects[0].(*widget.Label); !ok || label.Text != "Lima" {
+		t.Errorf("Expected rendered label 'Lima', got %#v", cell.Objects[0])
+	}
+
+	// Numeric sort works for both map types
+	if err := table.Sort("age", true); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	var names []string
+	for _, item := range table.data {
+		names = append(names, table.extractFieldValue(item, "name"))
+	}
+	if strings.Join(names, ",") != "alice,Bob,Carol" {
+		t.Errorf("Expected age order alice,Bob,Carol, got %v", names)
+	}
+
+	// Default string sort
+	if err := table.Sort("name", false); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	if got := table.extractFieldValue(table.data[0], "name"); got != "alice" {
+		t.Errorf("Expected 'alice' first in descending string order, got %q", got)
+	}
+
+	// Filtering matches key values
+	table.SetFilter("lima", false)
+	if len(table.state.visibleRows) != 1 || table.extractFieldValue(table.data[table.state.visibleRows[0]], "name") != "Bob" {
+		t.Errorf("Expected filter to match only Bob, got rows %v", table.state.visibleRows)
+	}
+}
+
+// TestNestedFieldPaths tests dotted column IDs resolving nested struct, pointer and map fields
+func TestNestedFieldPaths(t *testing.T) {
+	type contact struct {
+		Email string
+	}
+	type customer struct {
+		Name    string
+		Contact *contact
+	}
+	type order struct {
+		ID       int
+		Customer *customer
+		Meta     map[string]interface{}
+	}
+
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "Customer.Name", Title: "Customer", Sortable: true},
+		{ID: "customer.contact.email", Title: "Email", Sortable: true},
+		{ID: "Meta.Region", Title: "Region"},
+	}
+	config.FilterColumns = []string{"customer.contact.email"}
+	table := createTestTable(config)
+	table.SetData([]interface{}{
+		order{ID: 1, Customer: &customer{Name: "Zoe", Contact: &contact{Email: "zoe@example.com"}}, Meta: map[string]interface{}{"region": "EU"}},
+		order{ID: 2, Customer: &customer{Name: "Adam"}}, // nil Contact (middle of three-level path)
+		&order{ID: 3, Customer: nil},                    // nil Customer
+		order{ID: 4, Customer: &customer{Name: "Mia", Contact: &contact{Email: "mia@example.com"}}},
+	})
+
+	// Two-level path
+	if got := table.extractFieldValue(table.data[0], "Customer.Name"); got != "Zoe" {
+		t.Errorf("Expected 'Zoe', got %q", got)
+	}
+	// Three-level path (case-insensitive segments)
+	if got := table.extractFieldValue(table.data[0], "customer.contact.email"); got != "zoe@example.com" {
+		t.Errorf("Expected 'zoe@example.com', got %q", got)
+	}
+	// Path through a map
+	if got := table.extractFieldValue(table.data[0], "Meta.Region"); got != "EU" {
+		t.Errorf("Expected 'EU', got %q", got)
+	}
+	// Nil intermediate values display as blank
+	if got := table.extractFieldValue(table.data[1], "customer.contact.email"); got != "" {
+		t.Errorf("Expected blank for nil Contact, got %q", got)
+	}
+	if got := table.extractFieldValue(table.data[2], "Customer.Name"); got != "" {
+		t.Errorf("Expected blank for nil Customer, got %q", got)
+	}
+	if got := table.extractFieldValue(table.data[1], "Meta.Region"); got != "" {
+		t.Errorf("Expected blank for nil map, got %q", got)
+	}
+
+	// Comparators resolve the same path
+	if err := table.Sort("Customer.Name", true); err != nil {
+		t.Fatalf("Sort failed: %v", err)
+	}
+	var ids []int
+	for _, item := range table.data {
+		if o, ok := item.(order); ok {
+			ids = append(ids, o.ID)
+		} else {
+			ids = append(ids, item.(*order).ID)
+		}
+	}
+	if fmt.Sprint(ids) != "[3 2 4 1]" { // blank, Adam, Mia, Zoe
+		t.Errorf("Expected order [3 2 4 1], got %v", ids)
+	}
+	if NewNumericComparator("Customer.Contact")(table.data[0], table.data[1]) != 0 {
+		t.Error("Expected numeric comparator to treat unresolved paths as equal")
+	}
+
+	// Filtering uses the path too
+	table.SetFilter("mia@", false)
+	if len(table.state.visibleRows) != 1 {
+		t.Errorf("Expected 1 row matching email filter, got %d", len(table.state.visibleRows))
+	}
+}
+
+// TestCellBounds tests cell geometry for known widths, display order and scrolling
+func TestCellBounds(t *testing.T) {
+	test.NewTempApp(t)
+
+	config := createTestConfig()
+	table := NewTable(config)
+	var data []interface{}
+	for i := 0; i < 50; i++ {
+		data = append(data, map[string]interface{}{"id": i, "name": fmt.Sprintf("row %d", i), "status": "Active", "priority": 50 - i})
+	}
+	table.SetData(data)
+	w := test.NewWindow(table)
+	defer w.Close()
+	w.Resize(fyne.NewSize(500, 300))
+
+	padding := theme.Padding()
+	pos0, size0, ok := table.CellBounds(0, 0)
+	if !ok {
+		t.Fatal("Expected bounds for visible cell")
+	}
+	if pos0.X != 0 || size0.Width != 50 {
+		t.Errorf("Column 0: expected x=0 width=50, got x=%v width=%v", pos0.X, size0.Width)
+	}
+
+	pos, size, _ := table.CellBounds(0, 2)
+	if wantX := 50 + padding + 150 + padding; pos.X != wantX || size.Width != 100 {
+		t.Errorf("Column 2: expected x=%v width=100, got x=%v width=%v", wantX, pos.X, size.Width)
+	}
+
+	pos1, _, _ := table.CellBounds(1, 0)
+	if got, want := pos1.Y-pos0.Y, size0.Height+padding; got != want {
+		t.Errorf("Expected rows %v apart, got %v", want, got)
+	}
+
+	// Scrolling shifts bounds by the scroll offset
+	before, _, _ := table.CellBounds(30, 0)
+	table.table.ScrollToOffset(fyne.NewPos(0, 40))
+	after, _, _ := table.CellBounds(30, 0)
+	if before.Y-after.Y != 40 {
+		t.Errorf("Expected scrolling by 40 to move cell up by 40, moved %v", before.Y-after.Y)
+	}
+	table.table.ScrollToOffset(fyne.NewPos(0, 0))
+
+	// Bounds follow display position, not data index
+	table.SetFilter("row 4", false) // Shows rows 4, 40..49
+	posFiltered, _, _ := table.CellBounds(40, 0)
+	if posFiltered.Y != pos1.Y {
+		t.Errorf("Expected second displayed row at y=%v, got %v", pos1.Y, posFiltered.Y)
+	}
+
+	// Hidden cells have no bounds
+	if _, _, ok := table.CellBounds(100, 0); ok {
+		t.Error("Expected no bounds for out-of-range row")
+	}
+	if _, _, ok := table.CellBounds(30, 0); ok {
+		t.Error("Expected no bounds for filtered-out row")
+	}
+}
+
+// TestEmptyPlaceholder tests that empty fields render the column placeholder, muted
+func TestEmptyPlaceholder(t *testing.T) {
+	config := createTestConfig()
+	config.Columns[1].EmptyPlaceholder = "—"
+	table := createTestTable(config)
+	table.SetData([]interface{}{
+		map[string]interface{}{"id": 1, "name": "", "status": "", "priority": 1},
+		map[string]interface{}{"id": 2, "name": "Bob", "status": "", "priority": 2},
+	})
+
+	render := func(col, row int) *widget.Label {
+		cell := container.NewStack(widget.NewLabel(""))
+		table.renderDataCell(col, row, cell)
+		return cell.Objects[0].(*widget.Label)
+	}
+
+	if label := render(1, 0); label.Text != "—" || label.Importance != widget.LowImportance {
+		t.Errorf("Expected muted placeholder, got %q (importance %v)", label.Text, label.Importance)
+	}
+	if label := render(1, 1); label.Text != "Bob" || label.Importance != widget.MediumImportance {
+		t.Errorf("Expected normal value, got %q (importance %v)", label.Text, label.Importance)
+	}
+	// Columns without a placeholder stay blank
+	if label := render(2, 0); label.Text != "" {
+		t.Errorf("Expected blank cell without placeholder, got %q", label.Text)
+	}
+
+	// Filtering and sorting still see the empty value
+	table.SetFilter("—", false)
+	if table.state.VisibleRowCount() != 0 {
+		t.Errorf("Expected placeholder not to match filter, got %d rows", table.state.VisibleRowCount())
+	}
+}
+
+// TestPopupIconDefaultRenderer tests that popup columns with ShowPopupIcon show the
+// dropdown glyph and GetCellValue text without a custom renderer
+func TestPopupIconDefaultRenderer(t *testing.T) {
+	test.NewTempApp(t)
+	config := createTestConfig()
+	config.Columns[2].PopupOptions = func(interface{}) []string { return []string{"active", "inactive"} }
+	config.Columns[2].ShowPopupIcon = true
+	config.Columns[2].GetCellValue = func(data interface{}) string { return strings.ToUpper(data.(TestData).Status) }
+	table := createTestTable(config)
+	table.SetData(createTestData())
+
+	render := func(col int) fyne.CanvasObject {
+		cell := container.NewStack(widget.NewLabel(""))
+		table.renderDataCell(col, 0, cell)
+		return cell.Objects[0]
+	}
+
+	box, ok := render(2).(*fyne.Container)
+	if !ok || len(box.Objects) != 2 {
+		t.Fatalf("Expected text and icon container, got %#v", render(2))
+	}
+	if label, ok := box.Objects[0].(*widget.Label); !ok || label.Text != "ACTIVE" {
+		t.Errorf("Expected GetCellValue text 'ACTIVE', got %#v", box.Objects[0])
+	}
+	if icon, ok := box.Objects[1].(*widget.Icon); !ok || icon.Resource != theme.MenuDropDownIcon() {
+		t.Errorf("Expected dropdown icon, got %#v", box.Objects[1])
+	}
+
+	// Without ShowPopupIcon the cell is plain text
+	config.Columns[2].ShowPopupIcon = false
+	if _, ok := render(2).(*widget.Label); !ok {
+		t.Errorf("Expected plain label without ShowPopupIcon, got %#v", render(2))
+	}
+
+	// The icon counts toward the auto-sized width
+	config.Columns[2].ShowPopupIcon = true
+	table.autoResizeColumn(2)
+	withIcon := config.Columns[2].Width
+	config.Columns[2].ShowPopupIcon = false
+	table.autoResizeColumn(2)
+	if withIcon <= config.Columns[2].Width {
+		t.Errorf("Expected icon to widen the column: %v vs %v", withIcon, config.Columns[2].Width)
+	}
+}
+
+// TestStructTags tests binding columns to fields with `table` struct tags
+func TestStructTags(t *testing.T) {
+	type contact struct {
+		FullName string `table:"name"`
+		Name     string // Nickname; the tag takes precedence for column "name"
+		Years    int    `table:"age,omitempty"`
+		Address  struct {
+			Town string `table:"city"`
+		}
+	}
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "name", Title: "Name", Sortable: true},
+		{ID: "age", Title: "Age", Sortable: true, Comparator: NewNumericComparator("age")},
+		{ID: "Address.city", Title: "City"},
+	}
+	config.FilterColumns = []string{"name"}
+	table := createTestTable(config)
+
+	var a, b contact
+	a.FullName, a.Name, a.Years, a.Address.Town = "Zoe Park", "Z", 40, "Oslo"
+	b.FullName, b.Name, b.Years, b.Address.Town = "Adam Lee", "Al", 9, "Rome"
+	table.SetData([]interface{}{a, b})
+
+	// Default renderer
+	for col, expected := range []string{"Zoe Park", "40", "Oslo"} {
+		if got := table.displayValue(a, config.Columns[col]); got != expected {
+			t.Errorf("Column %s: expected %q, got %q", config.Columns[col].ID, expected, got)
+		}
+	}
+
+	// Comparators resolve the same tags
+	table.Sort("name", true)
+	if got := table.data[0].(contact).FullName; got != "Adam Lee" {
+		t.Errorf("Expected name sort by tagged field, got %q first", got)
+	}
+	table.Sort("age", false)
+	if got := table.data[0].(contact).Years; got != 40 {
+		t.Errorf("Expected numeric sort by tagged field, got %d first", got)
+	}
+
+	// Filtering too
+	table.SetFilter("park", false)
+	if len(table.state.visibleRows) != 1 {
+		t.Errorf("Expected filter on tagged field to match 1 row, got %d", len(table.state.visibleRows))
+	}
+}
+
+// TestMixedRowTypes tests datasets mixing struct types that share only some fields
+func TestMixedRowTypes(t *testing.T) {
+	type task struct {
+		Name     string
+		Priority int
+	}
+	type note struct {
+		Name string
+		Text string
+	}
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "name", Title: "Name", Sortable: true},
+		{ID: "priority", Title: "Priority", Sortable: true, Comparator: NewNumericComparator("priority")},
+		{ID: "text", Title: "Text", Sortable: true},
+	}
+	table := createTestTable(config)
+	table.SetData([]interface{}{
+		task{Name: "Deploy", Priority: 2},
+		note{Name: "Idea", Text: "later"},
+		task{Name: "Build", Priority: 0},
+		&note{Name: "Memo", Text: "asap"},
+	})
+
+	// Missing fields display as blank, not as the whole row
+	if got := table.extractFieldValue(table.data[1], "priority"); got != "" {
+		t.Errorf("Expected blank for missing field, got %q", got)
+	}
+	if got := table.extractFieldValue(table.data[0], "text"); got != "" {
+		t.Errorf("Expected blank for missing field, got %q", got)
+	}
+
+	names := func() string {
+		var result []string
+		for _, item := range table.data {
+			switch v := item.(type) {
+			case task:
+				result = append(result, v.Name)
+			case note:
+				result = append(result, v.Name)
+			case *note:
+				result = append(result, v.Name)
+			}
+		}
+		return strings.Join(result, ",")
+	}
+
+	// Rows lacking the field sort after the others, even after a zero value
+	table.Sort("priority", true)
+	if got := names(); !strings.HasPrefix(got, "Build,Deploy,") {
+		t.Errorf("Expected tasks by priority then notes, got %s", got)
+	}
+	table.Sort("text", true)
+	if got := names(); !strings.HasPrefix(got, "Memo,Idea,") {
+		t.Errorf("Expected notes by text first, got %s", got)
+	}
+
+	// Shared fields sort across types
+	table.Sort("name", true)
+	if got := names(); got != "Build,Deploy,Idea,Memo" {
+		t.Errorf("Expected all rows by name, got %s", got)
+	}
+}
+
+// TestSelectableCells tests that only the selected cell's text is selectable
+func TestSelectableCells(t *testing.T) {
+	config := createTestConfig()
+	config.RowSelectOnlyMode = false
+	config.SelectableCells = true
+	table := createTestTable(config)
+	table.SetData(createTestData())
+	table.SetSelectedCell(1, 2)
+
+	render := func(col, row int) *widget.Label {
+		cell := container.NewStack(widget.NewLabel(""))
+		table.renderDataCell(col, row, cell)
+		var label *widget.Label
+		var find func(obj fyne.CanvasObject)
+		find = func(obj fyne.CanvasObject) {
+			switch o := obj.(type) {
+			case *widget.Label:
+				label = o
+			case *fyne.Container:
+				for _, child := range o.Objects {
+					find(child)
+				}
+			}
+		}
+		find(cell)
+		return label
+	}
+
+	if !render(2, 1).Selectable {
+		t.Error("Expected selected cell to be selectable")
+	}
+	if render(1, 1).Selectable || render(2, 0).Selectable {
+		t.Error("Expected other cells not to be selectable, so clicks still select them")
+	}
+
+	// Row-only mode: the whole selected row
+	config.RowSelectOnlyMode = true
+	if !render(1, 1).Selectable {
+		t.Error("Expected selected row's cells to be selectable in row-only mode")
+	}
+
+	config.SelectableCells = false
+	if render(2, 1).Selectable {
+		t.Error("Expected no selectable text with SelectableCells off")
+	}
+}
+
+// inFlightViewOp returns the context of the filter or sort request of kind in flight
+func inFlightViewOp(table *Table, kind viewOpKind) context.Context {
+	table.viewOpMu.Lock()
+	defer table.viewOpMu.Unlock()
+	return table.viewOps[kind].ctx
+}
+
+// waitForCancel waits until ctx, a request's context, is cancelled by a newer request
+func waitForCancel(t *testing.T, ctx context.Context) {
+	t.Helper()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for the request to be superseded")
+	}
+}
+
+// TestSupersededFilterIsCancelled tests that a newer filter cancels the scan in flight
+// instead of waiting for it to finish
+func TestSupersededFilterIsCancelled(t *testing.T) {
+	const rows = 10000
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{{ID: "name", Title: "Name"}}
+	config.FilterColumns = []string{"name"}
+	table := createTestTable(config)
+	data := make([]interface{}, rows)
+	for i := range data {
+		data[i] = map[string]interface{}{"name": fmt.Sprintf("row %d", i)}
+	}
+	table.SetData(data)
+
+	// The first scan blocks on its first row until the second filter has started
+	var scanned atomic.Int64
+	started, release := make(chan struct{}), make(chan struct{})
+	table.state.rowFilter = func(interface{}) bool {
+		if scanned.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+		return true
+	}
+
+	done := make(chan struct{}, 2)
+	go func() { table.SetFilter("row", false); done <- struct{}{} }()
+	<-started
+	first := inFlightViewOp(table, viewOpFilter)
+	go func() { table.SetFilter("row 1", false); done <- struct{}{} }()
+	waitForCancel(t, first)
+	close(release)
+	<-done
+	<-done
+
+	// The superseded scan stopped at its next check; the latest filter scanned everything
+	if got := scanned.Load(); got > rows+cancelCheckInterval {
+		t.Errorf("Expected the superseded scan to stop early, scanned %d rows for 2 filters of %d", got, rows)
+	}
+	if table.state.filterText != "row 1" {
+		t.Errorf("Expected the latest filter to win, got %q", table.state.filterText)
+	}
+	if got := table.GetVisibleRowCount(); got != 1111 { // "row 1", "row 10".."row 19", ...
+		t.Errorf("Expected 1111 rows matching the latest filter, got %d", got)
+	}
+}
+
+// TestSupersededSortIsCancelled tests that a newer sort cancels the sort in flight,
+// leaving the data as the newer sort orders it
+func TestSupersededSortIsCancelled(t *testing.T) {
+	const rows = 10000
+	var slowCompares atomic.Int64
+	started, release := make(chan struct{}), make(chan struct{})
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "n", Title: "N", Sortable: true, Comparator: func(a, b interface{}) int {
+			if slowCompares.Add(1) == 1 {
+				close(started)
+				<-release
+			}
+			return NewNumericComparator("n")(a, b)
+		}},
+		{ID: "name", Title: "Name", Sortable: true},
+	}
+	table := createTestTable(config)
+	data := make([]interface{}, rows)
+	for i := range data {
+		data[i] = map[string]interface{}{"n": rows - i, "name": fmt.Sprintf("%05d", i)}
+	}
+	table.SetData(data)
+
+	done := make(chan error, 2)
+	go func() { done <- table.Sort("n", true) }()
+	<-started
+	first := inFlightViewOp(table, viewOpSort)
+	go func() { done <- table.Sort("name", false) }()
+	waitForCancel(t, first)
+	close(release)
+	for range 2 {
+		if err := <-done; err != nil {
+			t.Fatalf("Sort failed: %v", err)
+		}
+	}
+
+	if got := slowCompares.Load(); got > cancelCheckInterval {
+		t.Errorf("Expected the superseded sort to stop comparing early, got %d comparisons", got)
+	}
+	if column, ascending, _ := table.GetSortState(); column != "name" || ascending {
+		t.Errorf("Expected the latest sort (name, descending) to win, got %s ascending=%v", column, ascending)
+	}
+	if first := table.data[0].(map[string]interface{})["name"]; first != fmt.Sprintf("%05d", rows-1) {
+		t.Errorf("Expected data sorted by name descending, first row %v", first)
+	}
+}
+
+// TestHeaderClickSupersedesSort tests that sorting from a header click cancels the
+// sort in flight like any other sort request
+func TestHeaderClickSupersedesSort(t *testing.T) {
+	const rows = 500
+	started, release := make(chan struct{}), make(chan struct{})
+	var once sync.Once
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "n", Title: "N", Sortable: true, Comparator: func(a, b interface{}) int {
+			once.Do(func() {
+				close(started)
+				<-release
+			})
+			return NewNumericComparator("n")(a, b)
+		}},
+		{ID: "name", Title: "Name", Sortable: true},
+	}
+	table := createTestTable(config)
+	data := make([]interface{}, rows)
+	for i := range data {
+		data[i] = map[string]interface{}{"n": rows - i, "name": fmt.Sprintf("%05d", i)}
+	}
+	table.SetData(data)
+
+	done := make(chan struct{}, 2)
+	go func() { _ = table.Sort("n", true); done <- struct{}{} }()
+	<-started
+	first := inFlightViewOp(table, viewOpSort)
+	go func() { table.MouseHandler.HandleHeaderClick(1, table); done <- struct{}{} }()
+	waitForCancel(t, first)
+	close(release)
+	<-done
+	<-done
+
+	if column, ascending, _ := table.GetSortState(); column != "name" || !ascending {
+		t.Errorf("Expected the header click sort (name, ascending) to win, got %s ascending=%v", column, ascending)
+	}
+}
+
+// TestFilterDoesNotCancelSort tests that a filter started while a sort is running
+// leaves the sort to finish
+func TestFilterDoesNotCancelSort(t *testing.T) {
+	const rows = 500
+	var compares atomic.Int64
+	started, release := make(chan struct{}), make(chan struct{})
+	config := NewConfig("test")
+	config.Columns = []ColumnConfig{
+		{ID: "name", Title: "Name", Sortable: true, Comparator: func(a, b interface{}) int {
+			if compares.Add(1) == 1 {
+				close(started)
+				<-release
+			}
+			return NewStringComparator("name")(a, b)
+		}},
+	}
+	config.FilterColumns = []string{"name"}
+	table := createTestTable(config)
+	data := make([]interface{}, rows)
+	for i := range data {
+		data[i] = map[string]interface{}{"name": fmt.Sprintf("%03d", rows-1-i)}
+	}
+	table.SetData(data)
+
+	done := make(chan error, 2)
+	go func() { done <- table.Sort("name", true) }()
+	<-started
+	sorting := inFlightViewOp(table, viewOpSort)
+	go func() { done <- table.SetFilter("1", false) }()
+	deadline := time.Now().Add(time.Second)
+	for inFlightViewOp(table, viewOpFilter) == nil && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if sorting.Err() != nil {
+		t.Error("Expected the filter not to cancel the sort in flight")
+	}
+	close(release)
+	for range 2 {
+		if err := <-done; err != nil {
+			t.Fatalf("Sort or filter failed: %v", err)
+		}
+	}
+
+	if column, ascending, _ := table.GetSortState(); column != "name" || !ascending {
+		t.Errorf("Expected the sort to survive the filter, got %q ascending=%v", column, ascending)
+	}
+	if first := table.data[0].(map[string]interface{})["name"]; first != "000" {
+		t.Errorf("Expected data sorted by name, first row %v", first)
+	}
+	if rows := table.state.visibleRows; len(rows) == 0 || table.data[rows[0]].(map[string]interface{})["name"] != "001" {
+		t.Errorf("Expected the filtered rows in sorted order, got %v", rows)
+	}
+}
+
+// TestDensityPresets tests the sizes each Density resolves to and that explicit
+// sizes override the preset
+func TestDensityPresets(t *testing.T) {
+	tests := []struct {
+		density                           Density
+		rowHeight, headerHeight, fontSize float32
+	}{
+		{DensityComfortable, 35, 30, 0},
+		{DensityCompact, 24, 24, 11},
+		{DensitySpacious, 44, 36, 16},
+	}
+	for _, tt := range tests {
+		config := NewConfig("test")
+		config.Density = tt.density
+		if config.rowHeight() != tt.rowHeight || config.headerHeight() != tt.headerHeight || config.fontSize() != tt.fontSize {
+			t.Errorf("Density %d: got row %v, header %v, font %v; want %v, %v, %v", tt.density,
+				config.rowHeight(), config.headerHeight(), config.fontSize(), tt.rowHeight, tt.headerHeight, tt.fontSize)
+		}
+
+		config.RowHeight, config.HeaderHeight, config.FontSize = 50, 40, 13
+		if config.rowHeight() != 50 || config.headerHeight() != 40 || config.fontSize() != 13 {
+			t.Errorf("Density %d: expected explicit sizes to override the preset", tt.density)
+		}
+	}
+}
+
+// TestSetDensity tests that the table's rows follow the density at runtime
+func TestSetDensity(t *testing.T) {
+	test.NewTempApp(t)
+	table := NewTable(createTestConfig())
+	table.SetData(createTestData())
+	w := test.NewWindow(table)
+	defer w.Close()
+	w.Resize(fyne.NewSize(600, 400))
+
+	rowHeight := func() float32 {
+		return table.tableInternal("cellSize").Interface().(fyne.Size).Height
+	}
+	comfortable := rowHeight()
+
+	table.SetDensity(DensityCompact)
+	if got := rowHeight(); got != 24 {
+		t.Errorf("Compact: expected 24px rows, got %v (comfortable %v)", got, comfortable)
+	}
+	table.SetDensity(DensitySpacious)
+	if got := rowHeight(); got != 44 {
+		t.Errorf("Spacious: expected 44px rows, got %v", got)
+	}
+	table.SetDensity(DensityComfortable)
+	if got := rowHeight(); got != comfortable {
+		t.Errorf("Comfortable: expected %v rows again, got %v", comfortable, got)
+	}
+}
+
+// headerTooltipArea returns the tooltip area of a rendered header cell, or nil if none
+func headerTooltipArea(cell *fyne.Container) *tooltipArea {
+	if len(cell.Objects) != 1 {
+		return nil
+	}
+	stack, ok := cell.Objects[0].(*fyne.Container)
+	if !ok || len(stack.Objects) != 2 {
+		return nil
+	}
+	area, _ := stack.Objects[1].(*tooltipArea)
+	return area
+}
+
+// TestHeaderTooltip tests that headers with HeaderTooltip show it on hover, for both
+// sort buttons and plain labels, and that headers without one get no hover area
+func TestHeaderTooltip(t *testing.T) {
+	test.NewTempApp(t)
+	config := createTestConfig()
+	config.Columns[0].HeaderTooltip = "Unique row ID" // Plain label
+	config.Columns[1].Sortable = true
+	config.Columns[1].HeaderTooltip = "Full name" // Sort button
+	table := createTestTable(config)
+
+	for col, want := range map[int]string{0: "Unique row ID", 1: "Full name"} {
+		cell := container.NewStack()
+		table.renderHeaderCell(col, cell)
+		area := headerTooltipArea(cell)
+		if area == nil {
+			t.Fatalf("Column %d: expected a tooltip area", col)
+		}
+		if _, isButton := area.target.(*widget.Button); isButton != (col == 1) {
+			t.Errorf("Column %d: expected hover events passed to the sort button only, target %T", col, area.target)
+		}
+
+		w := test.NewWindow(cell)
+		area.MouseIn(&desktop.MouseEvent{})
+		area.showTooltip(fyne.NewPos(5, 5)) // As the delay would
+		if area.popUp == nil || !area.popUp.Visible() {
+			t.Errorf("Column %d: expected the tooltip to show", col)
+		} else if label := area.popUp.Content.(*widget.Label); label.Text != want {
+			t.Errorf("Column %d: expected tooltip %q, got %q", col, want, label.Text)
+		}
+		popUp := area.popUp
+		area.MouseOut()
+		if popUp != nil && popUp.Visible() {
+			t.Errorf("Column %d: expected the tooltip to hide on mouse out", col)
+		}
+		w.Close()
+	}
+
+	// No tooltip, no hover area
+	cell := container.NewStack()
+	table.renderHeaderCell(2, cell)
+	if headerTooltipArea(cell) != nil {
+		t.Error("Expected no tooltip area for a header without HeaderTooltip")
+	}
+}
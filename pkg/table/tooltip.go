@@ -0,0 +1,98 @@
+package table
+
+import (
+	"image/color"
+	"time"
+
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+)
+
+// Header tooltips
+//
+// Fyne has no tooltips, so a header with ColumnConfig.HeaderTooltip gets a transparent
+// hover area laid over its title. Resting the pointer on it shows the text in a small
+// pop-up after tooltipDelay. The area is not tappable, so clicks still reach a sort
+// button underneath, and hover events are passed on so the button still highlights.
+
+// tooltipDelay is how long the pointer rests on a header before its tooltip shows
+const tooltipDelay = 500 * time.Millisecond
+
+// tooltipArea shows a tooltip while the pointer rests on it
+type tooltipArea struct {
+	widget.BaseWidget
+	text   string
+	target desktop.Hoverable // Widget underneath that also receives hover events (nil = none)
+
+	hovering bool
+	timer    *time.Timer
+	popUp    *widget.PopUp
+}
+
+// withTooltip lays a tooltip area over content, or returns content unchanged if text is empty
+func withTooltip(content fyne.CanvasObject, text string) fyne.CanvasObject {
+	if text == "" {
+		return content
+	}
+	area := &tooltipArea{text: text}
+	area.target, _ = content.(desktop.Hoverable)
+	area.ExtendBaseWidget(area)
+	return container.NewStack(content, area)
+}
+
+// CreateRenderer implements fyne.Widget
+func (a *tooltipArea) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(canvas.NewRectangle(color.Transparent))
+}
+
+// MouseIn starts the delay before the tooltip shows
+func (a *tooltipArea) MouseIn(ev *desktop.MouseEvent) {
+	if a.target != nil {
+		a.target.MouseIn(ev)
+	}
+	a.hovering = true
+	pos := ev.AbsolutePosition
+	a.timer = time.AfterFunc(tooltipDelay, func() {
+		fyne.Do(func() {
+			if a.hovering {
+				a.showTooltip(pos)
+			}
+		})
+	})
+}
+
+// MouseMoved passes the event on to the widget underneath
+func (a *tooltipArea) MouseMoved(ev *desktop.MouseEvent) {
+	if a.target != nil {
+		a.target.MouseMoved(ev)
+	}
+}
+
+// MouseOut hides the tooltip, or cancels it if it hasn't shown yet
+func (a *tooltipArea) MouseOut() {
+	if a.target != nil {
+		a.target.MouseOut()
+	}
+	a.hovering = false
+	if a.timer != nil {
+		a.timer.Stop()
+		a.timer = nil
+	}
+	if a.popUp != nil {
+		a.popUp.Hide()
+		a.popUp = nil
+	}
+}
+
+// showTooltip shows the text in a pop-up just below the pointer at pos
+func (a *tooltipArea) showTooltip(pos fyne.Position) {
+	c := fyne.CurrentApp().Driver().CanvasForObject(a)
+	if c == nil || a.popUp != nil {
+		return
+	}
+	a.popUp = widget.NewPopUp(widget.NewLabel(a.text), c)
+	a.popUp.ShowAtPosition(pos.Add(fyne.NewPos(0, 16))) // Clear of the pointer
+}